@@ -0,0 +1,149 @@
+package iban
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// bundesbankLineLength is the length of a line of the Bundesbank BLZ file, without the line break.
+const bundesbankLineLength = 168
+
+// ReadBundesbankFile reads the Bankleitzahlendatei of the Deutsche Bundesbank in its fixed
+// width text format (ISO 8859-1). Only the main entry of every Bankleitzahl is used, not the
+// entries of its branches. The file is published with its validity period: every entry is valid
+// from validFrom, and the entries marked for deletion are valid until validUntil.
+func ReadBundesbankFile(r io.Reader, validFrom, validUntil time.Time) ([]Bank, error) {
+	var banks []Bank
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		record := string(decodeLatin1([]byte(strings.TrimRight(scanner.Text(), "\r"))))
+		if strings.TrimSpace(record) == "" {
+			continue
+		}
+		// The text is decoded into UTF-8, so the field positions are counted in characters
+		fields := []rune(record)
+		if len(fields) != bundesbankLineLength {
+			return nil, fmt.Errorf("IBAN: Bundesbank file line %d has %d characters, expected %d", line, len(fields), bundesbankLineLength)
+		}
+		field := func(from, to int) string {
+			return strings.TrimSpace(string(fields[from-1 : to]))
+		}
+
+		// Merkmal 1 marks the main entry of a Bankleitzahl, 2 the entries of its branches
+		if field(9, 9) != "1" {
+			continue
+		}
+		bank := Bank{
+			Country:     "DE",
+			BankCode:    field(1, 8),
+			Name:        field(10, 67),
+			PostalCode:  field(68, 72),
+			City:        field(73, 107),
+			BIC:         field(140, 150),
+			ValidFrom:   validFrom,
+			CheckMethod: field(151, 152),
+		}
+		// Änderungskennzeichen D: the Bankleitzahl is deleted at the end of the validity period
+		if field(159, 159) == "D" {
+			bank.ValidUntil = validUntil
+		}
+		banks = append(banks, bank)
+	}
+	return banks, scanner.Err()
+}
+
+// ReadDutchBICList reads the Dutch list of bank identifiers and BICs ("BIC-lijst") of the
+// Betaalvereniging Nederland, exported as CSV with the columns Identifier, BIC and
+// Naam betaaldienstverlener.
+func ReadDutchBICList(r io.Reader) ([]Bank, error) {
+	table, err := readDirectoryTable(r, []string{"Identifier", "Bank identifier"}, []string{"BIC"},
+		[]string{"Naam betaaldienstverlener", "Naam", "Name"})
+	if err != nil {
+		return nil, err
+	}
+	if err := table.require("Naam betaaldienstverlener"); err != nil {
+		return nil, err
+	}
+
+	var banks []Bank
+	for table.next() {
+		banks = append(banks, Bank{
+			Country:  "NL",
+			BankCode: strings.ToUpper(table.value("Identifier")),
+			Name:     table.value("Naam betaaldienstverlener"),
+			BIC:      strings.ToUpper(table.value("BIC")),
+		})
+	}
+	return banks, table.err
+}
+
+// ReadAustrianBankFile reads the bank directory of the Oesterreichische Nationalbank
+// ("SEPA-Zahlungsverkehrs-Verzeichnis"), a semicolon separated file in ISO 8859-1 with a few
+// lines before its header row and the columns Bankleitzahl, Bankenname, Straße, PLZ, Ort and SWIFT-Code.
+func ReadAustrianBankFile(r io.Reader) ([]Bank, error) {
+	table, err := readDirectoryTable(r, []string{"Bankleitzahl"}, []string{"Bankenname", "Bankname"},
+		[]string{"Straße", "Strasse"}, []string{"PLZ"}, []string{"Ort"}, []string{"SWIFT-Code", "BIC"})
+	if err != nil {
+		return nil, err
+	}
+	if err := table.require("Bankenname"); err != nil {
+		return nil, err
+	}
+
+	var banks []Bank
+	for table.next() {
+		banks = append(banks, Bank{
+			Country:    "AT",
+			BankCode:   table.value("Bankleitzahl"),
+			Name:       table.value("Bankenname"),
+			Address:    table.value("Straße"),
+			PostalCode: table.value("PLZ"),
+			City:       table.value("Ort"),
+			BIC:        strings.ToUpper(table.value("SWIFT-Code")),
+		})
+	}
+	return banks, table.err
+}
+
+// ReadSwissBankMaster reads the bank master file of SIX Interbank Clearing ("Bankenstamm"),
+// exported as CSV with the columns IID, Bank or institution name, Street name, Building number,
+// Post code, Town name, BIC and Valid from. The IIDs are left-padded with zeros to the five digits
+// they have in Swiss IBANs.
+func ReadSwissBankMaster(r io.Reader) ([]Bank, error) {
+	table, err := readDirectoryTable(r, []string{"IID", "BC-Nr", "Bank-clearing number"},
+		[]string{"Bank or institution name", "Bankname", "Name of bank/institution"},
+		[]string{"Street name", "Domicile address"}, []string{"Building number"},
+		[]string{"Post code", "Postal code", "PLZ"}, []string{"Town name", "Place", "Ort"},
+		[]string{"BIC", "SWIFT", "SWIFT-BIC"}, []string{"Valid from"})
+	if err != nil {
+		return nil, err
+	}
+	if err := table.require("Bank or institution name"); err != nil {
+		return nil, err
+	}
+
+	var banks []Bank
+	for table.next() {
+		iid := table.value("IID")
+		if len(iid) < 5 {
+			iid = strings.Repeat("0", 5-len(iid)) + iid
+		}
+		bank := Bank{
+			Country:    "CH",
+			BankCode:   iid,
+			Name:       table.value("Bank or institution name"),
+			Address:    joinNonEmpty(" ", table.value("Street name"), table.value("Building number")),
+			PostalCode: table.value("Post code"),
+			City:       table.value("Town name"),
+			BIC:        strings.ToUpper(table.value("BIC")),
+		}
+		if bank.ValidFrom, err = parseDirectoryDate(table.value("Valid from")); err != nil {
+			return nil, table.errorf("%v", err)
+		}
+		banks = append(banks, bank)
+	}
+	return banks, table.err
+}
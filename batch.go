@@ -0,0 +1,375 @@
+package iban
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// BatchFormat is the file format of a batch.
+type BatchFormat int
+
+// The supported batch formats.
+const (
+	BatchCSV       BatchFormat = iota + 1 // Comma separated values with a header row
+	BatchJSONLines                        // One JSON object per line
+)
+
+// BatchOptions configures ValidateBatch.
+type BatchOptions struct {
+	Format    BatchFormat // The format of the input and output, BatchCSV if zero
+	Column    string      // The CSV column or JSON field holding the IBAN, "iban" if empty
+	Comma     rune        // The CSV field separator, ',' if zero
+	Workers   int         // The number of concurrent validations, runtime.GOMAXPROCS if zero
+	Validator *Validator  // The validator to use, the default validator of NewIBAN if nil
+}
+
+// BatchSummary counts the results of a batch.
+type BatchSummary struct {
+	Rows     int            // The number of rows validated
+	Valid    int            // The number of valid IBANs
+	Invalid  int            // The number of invalid IBANs
+	ByReason map[Reason]int // The number of invalid IBANs by reason
+}
+
+// The names of the columns (CSV) or fields (JSON Lines) ValidateBatch adds to every row.
+const (
+	BatchColumnNormalized = "iban_normalized" // The electronic format of the IBAN, or the normalized input if invalid
+	BatchColumnValid      = "iban_valid"      // "true" or "false"
+	BatchColumnReason     = "iban_reason"     // The Reason of an invalid IBAN, e.g. "BadChecksum"
+	BatchColumnCountry    = "iban_country"    // The country code
+	BatchColumnBankCode   = "iban_bank_code"  // The bank code of a valid IBAN
+)
+
+// batchWindow is the number of rows per worker that can be read ahead of the output.
+const batchWindow = 64
+
+// batchRow is one row of the input.
+type batchRow struct {
+	input  string   // The IBAN as found in the row
+	record []string // The fields of a CSV row
+	object []byte   // The JSON object of a JSON Lines row
+}
+
+// batchResult is the validation result of a row.
+type batchResult struct {
+	row        batchRow
+	normalized string
+	country    string
+	bankCode   string
+	reason     Reason
+	err        error
+}
+
+// batchJob is a row to validate and the channel that receives its result.
+type batchJob struct {
+	row    batchRow
+	result chan<- batchResult
+}
+
+// ValidateBatch validates the IBANs in a column of a CSV or JSON Lines input and writes every
+// row to the output, in the input order, with the normalized IBAN, its validity, the reason it was
+// rejected, its country and its bank code added (see BatchColumnNormalized and the other columns).
+// CSV rows get the columns appended; JSON objects get the fields added after their own.
+//
+// The rows are validated by a pool of workers while they are read, and only a bounded number
+// of rows is held in memory, so inputs of any size can be streamed. Invalid IBANs do not stop the
+// batch; a malformed input, a missing CSV column or a write error does.
+func ValidateBatch(ctx context.Context, r io.Reader, w io.Writer, options BatchOptions) (BatchSummary, error) {
+	summary := BatchSummary{ByReason: map[Reason]int{}}
+	if options.Column == "" {
+		options.Column = "iban"
+	}
+	if options.Workers <= 0 {
+		options.Workers = runtime.GOMAXPROCS(0)
+	}
+	validator := options.Validator
+	if validator == nil {
+		validator = defaultValidator
+	}
+
+	var source batchSource
+	var sink batchSink
+	switch options.Format {
+	case BatchCSV, 0:
+		csvSource, err := newCSVSource(r, options)
+		if err != nil {
+			return summary, err
+		}
+		csvSink, err := newCSVSink(w, options, csvSource.header)
+		if err != nil {
+			return summary, err
+		}
+		source, sink = csvSource, csvSink
+	case BatchJSONLines:
+		source, sink = newJSONLinesSource(r, options.Column), newJSONLinesSink(w)
+	default:
+		return summary, fmt.Errorf("IBAN: unknown batch format %d", options.Format)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan batchJob, options.Workers)
+	for i := 0; i < options.Workers; i++ {
+		go func() {
+			for job := range jobs {
+				job.result <- validateRow(validator, job.row)
+			}
+		}()
+	}
+
+	// The reader queues the result channels in the input order, so the writer can wait on them in turn
+	results := make(chan chan batchResult, options.Workers*batchWindow)
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(jobs)
+		defer close(results)
+		for {
+			// Stop before reading the next row, so no row is read from r after ValidateBatch returned
+			if err := ctx.Err(); err != nil {
+				readErr <- err
+				return
+			}
+			row, err := source.next()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					err = nil
+				}
+				readErr <- err
+				return
+			}
+			result := make(chan batchResult, 1)
+			select {
+			case results <- result:
+			case <-ctx.Done():
+				readErr <- ctx.Err()
+				return
+			}
+			jobs <- batchJob{row: row, result: result}
+		}
+	}()
+
+	for result := range results {
+		res := <-result
+		if err := sink.write(res); err != nil {
+			// Stop the reader and wait for it before returning
+			cancel()
+			for range results {
+			}
+			<-readErr
+			return summary, err
+		}
+		summary.Rows++
+		if res.err == nil {
+			summary.Valid++
+		} else {
+			summary.Invalid++
+			summary.ByReason[res.reason]++
+		}
+	}
+	if err := <-readErr; err != nil {
+		return summary, err
+	}
+	return summary, sink.flush()
+}
+
+// validateRow validates the IBAN of a row.
+func validateRow(validator *Validator, row batchRow) batchResult {
+	result := batchResult{row: row}
+	parsed, err := validator.Validate(row.input)
+	if err == nil {
+		result.normalized = parsed.Electronic()
+		result.country = parsed.CountryCode
+		result.bankCode = parsed.BankCode
+		return result
+	}
+
+	result.normalized, _ = Normalize(row.input)
+	result.err = err
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		result.reason = validationErr.Reason
+		result.country = validationErr.Country
+	}
+	return result
+}
+
+// columns returns the values of the columns added to a row.
+func (r batchResult) columns() []string {
+	var reason string
+	if r.reason != 0 {
+		reason = r.reason.String()
+	}
+	return []string{r.normalized, strconv.FormatBool(r.err == nil), reason, r.country, r.bankCode}
+}
+
+// batchColumns are the names of the columns added to a row, in the order of batchResult.columns.
+var batchColumns = []string{BatchColumnNormalized, BatchColumnValid, BatchColumnReason, BatchColumnCountry, BatchColumnBankCode}
+
+// batchSource reads the rows of a batch; it returns io.EOF after the last row.
+type batchSource interface {
+	next() (batchRow, error)
+}
+
+// batchSink writes the annotated rows of a batch.
+type batchSink interface {
+	write(result batchResult) error
+	flush() error
+}
+
+// csvSource reads the rows of a CSV input.
+type csvSource struct {
+	reader *csv.Reader
+	header []string
+	column int
+}
+
+// newCSVSource reads the header of a CSV input and finds the IBAN column in it.
+func newCSVSource(r io.Reader, options BatchOptions) (*csvSource, error) {
+	reader := csv.NewReader(r)
+	if options.Comma != 0 {
+		reader.Comma = options.Comma
+	}
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("IBAN: reading the CSV header: %w", err)
+	}
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\uFEFF")
+	}
+	for i, name := range header {
+		if strings.EqualFold(strings.TrimSpace(name), options.Column) {
+			return &csvSource{reader: reader, header: header, column: i}, nil
+		}
+	}
+	return nil, fmt.Errorf("IBAN: the CSV header has no column <%s>", options.Column)
+}
+
+func (s *csvSource) next() (batchRow, error) {
+	record, err := s.reader.Read()
+	if err != nil {
+		return batchRow{}, err
+	}
+	row := batchRow{record: record}
+	if s.column < len(record) {
+		row.input = record[s.column]
+	}
+	return row, nil
+}
+
+// csvSink writes the rows of a CSV output.
+type csvSink struct {
+	writer *csv.Writer
+}
+
+// newCSVSink writes the header of a CSV output.
+func newCSVSink(w io.Writer, options BatchOptions, header []string) (*csvSink, error) {
+	writer := csv.NewWriter(w)
+	if options.Comma != 0 {
+		writer.Comma = options.Comma
+	}
+	if err := writer.Write(append(header[:len(header):len(header)], batchColumns...)); err != nil {
+		return nil, err
+	}
+	return &csvSink{writer: writer}, nil
+}
+
+func (s *csvSink) write(result batchResult) error {
+	record := result.row.record
+	return s.writer.Write(append(record[:len(record):len(record)], result.columns()...))
+}
+
+func (s *csvSink) flush() error {
+	s.writer.Flush()
+	return s.writer.Error()
+}
+
+// jsonLinesSource reads the rows of a JSON Lines input.
+type jsonLinesSource struct {
+	scanner *bufio.Scanner
+	column  string
+	line    int
+}
+
+// newJSONLinesSource creates a source that reads the IBAN from the given field of every object.
+func newJSONLinesSource(r io.Reader, column string) *jsonLinesSource {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	return &jsonLinesSource{scanner: scanner, column: column}
+}
+
+func (s *jsonLinesSource) next() (batchRow, error) {
+	for s.scanner.Scan() {
+		s.line++
+		object := bytes.TrimSpace(s.scanner.Bytes())
+		if len(object) == 0 {
+			continue
+		}
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(object, &fields); err != nil || object[0] != '{' {
+			return batchRow{}, fmt.Errorf("IBAN: line %d is not a JSON object", s.line)
+		}
+		row := batchRow{object: bytes.Clone(object)}
+		if value, exists := fields[s.column]; exists {
+			if err := json.Unmarshal(value, &row.input); err != nil {
+				row.input = string(value)
+			}
+		}
+		return row, nil
+	}
+	if err := s.scanner.Err(); err != nil {
+		return batchRow{}, err
+	}
+	return batchRow{}, io.EOF
+}
+
+// jsonLinesSink writes the rows of a JSON Lines output.
+type jsonLinesSink struct {
+	writer *bufio.Writer
+}
+
+// newJSONLinesSink creates a sink that adds the columns as fields to every object.
+func newJSONLinesSink(w io.Writer) *jsonLinesSink {
+	return &jsonLinesSink{writer: bufio.NewWriter(w)}
+}
+
+func (s *jsonLinesSink) write(result batchResult) error {
+	// Keep the object as it is and add the fields before its closing brace
+	object := result.row.object
+	s.writer.Write(object[:len(object)-1])
+	separator := ","
+	if len(bytes.TrimSpace(object[1:len(object)-1])) == 0 {
+		separator = ""
+	}
+	for i, value := range result.columns() {
+		name, _ := json.Marshal(batchColumns[i])
+		var encoded []byte
+		if batchColumns[i] == BatchColumnValid {
+			encoded = []byte(value)
+		} else {
+			encoded, _ = json.Marshal(value)
+		}
+		s.writer.WriteString(separator)
+		s.writer.Write(name)
+		s.writer.WriteByte(':')
+		s.writer.Write(encoded)
+		separator = ","
+	}
+	_, err := s.writer.WriteString("}\n")
+	return err
+}
+
+func (s *jsonLinesSink) flush() error {
+	return s.writer.Flush()
+}
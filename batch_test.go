@@ -0,0 +1,132 @@
+package iban
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestValidateBatchCSV(t *testing.T) {
+	input := "supplier;IBAN\n" +
+		"Acme;GB82 WEST 1234 5698 7654 32\n" +
+		"Widgets;GB83 WEST 1234 5698 7654 32\n" +
+		"\"Foo; Bar\";de89 3704 0044 0532 0130 00\n"
+	expected := "supplier;IBAN;iban_normalized;iban_valid;iban_reason;iban_country;iban_bank_code\n" +
+		"Acme;GB82 WEST 1234 5698 7654 32;GB82WEST12345698765432;true;;GB;WEST\n" +
+		"Widgets;GB83 WEST 1234 5698 7654 32;GB83WEST12345698765432;false;BadChecksum;GB;\n" +
+		"\"Foo; Bar\";de89 3704 0044 0532 0130 00;DE89370400440532013000;true;;DE;37040044\n"
+
+	var output bytes.Buffer
+	summary, err := ValidateBatch(context.Background(), strings.NewReader(input), &output, BatchOptions{Comma: ';'})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output.String() != expected {
+		t.Errorf("got output\n%s\nexpected\n%s", output.String(), expected)
+	}
+	if summary.Rows != 3 || summary.Valid != 2 || summary.Invalid != 1 || summary.ByReason[BadChecksum] != 1 {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+}
+
+func TestValidateBatchJSONLines(t *testing.T) {
+	input := `{"id":1,"account":"GB82 WEST 1234 5698 7654 32"}` + "\n\n" +
+		`{"id":2,"account":12345}` + "\n" + `{}` + "\n"
+	expected := `{"id":1,"account":"GB82 WEST 1234 5698 7654 32","iban_normalized":"GB82WEST12345698765432","iban_valid":true,"iban_reason":"","iban_country":"GB","iban_bank_code":"WEST"}` + "\n" +
+		`{"id":2,"account":12345,"iban_normalized":"12345","iban_valid":false,"iban_reason":"TooShort","iban_country":"","iban_bank_code":""}` + "\n" +
+		`{"iban_normalized":"","iban_valid":false,"iban_reason":"TooShort","iban_country":"","iban_bank_code":""}` + "\n"
+
+	var output bytes.Buffer
+	summary, err := ValidateBatch(context.Background(), strings.NewReader(input), &output,
+		BatchOptions{Format: BatchJSONLines, Column: "account"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output.String() != expected {
+		t.Errorf("got output\n%s\nexpected\n%s", output.String(), expected)
+	}
+	if summary.Rows != 3 || summary.Valid != 1 || summary.ByReason[TooShort] != 2 {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+}
+
+func TestValidateBatchOrder(t *testing.T) {
+	var input, expected strings.Builder
+	input.WriteString("iban\n")
+	for i := 0; i < 5000; i++ {
+		number := fmt.Sprintf("GB%02dWEST1234569876%04d", i%100, i)
+		fmt.Fprintf(&input, "%s\n", number)
+		fmt.Fprintf(&expected, "%s,%s\n", number, number)
+	}
+
+	var output bytes.Buffer
+	summary, err := ValidateBatch(context.Background(), strings.NewReader(input.String()), &output, BatchOptions{Workers: 8})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Rows != 5000 {
+		t.Errorf("expected 5000 rows, got %d", summary.Rows)
+	}
+	for i, line := range strings.Split(strings.TrimSpace(output.String()), "\n")[1:] {
+		if number := fmt.Sprintf("GB%02dWEST1234569876%04d", i%100, i); !strings.HasPrefix(line, number+","+number+",") {
+			t.Fatalf("row %d: expected %s, got %s", i, number, line)
+		}
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestValidateBatchErrors(t *testing.T) {
+	if _, err := ValidateBatch(context.Background(), strings.NewReader("name,account\n"), &bytes.Buffer{}, BatchOptions{}); err == nil {
+		t.Error("expected an error for a missing column")
+	}
+	if _, err := ValidateBatch(context.Background(), strings.NewReader("{\"iban\":\"x\"}\n[1]\n"), &bytes.Buffer{},
+		BatchOptions{Format: BatchJSONLines}); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("expected an error for line 2, got %v", err)
+	}
+
+	input := "iban\n" + strings.Repeat("GB82WEST12345698765432\n", 100000)
+	if _, err := ValidateBatch(context.Background(), strings.NewReader(input), failingWriter{}, BatchOptions{}); err == nil {
+		t.Error("expected the write error")
+	}
+}
+
+// endlessReader returns the header once and then the row forever, and counts the calls to Read.
+type endlessReader struct {
+	header, row string
+	reads       atomic.Int64
+}
+
+func (r *endlessReader) Read(p []byte) (int, error) {
+	if r.reads.Add(1) == 1 {
+		return copy(p, r.header), nil
+	}
+	return copy(p, r.row), nil
+}
+
+func TestValidateBatchStopsReadingOnWriteError(t *testing.T) {
+	for format, r := range map[BatchFormat]*endlessReader{
+		BatchCSV:       {header: "iban\n", row: "GB82WEST12345698765432\n"},
+		BatchJSONLines: {header: "\n", row: `{"iban":"GB82WEST12345698765432"}` + "\n"},
+	} {
+		_, err := ValidateBatch(context.Background(), r, failingWriter{}, BatchOptions{Format: format})
+		if err == nil || err.Error() != "disk full" {
+			t.Errorf("format %d: expected the write error, got %v", format, err)
+		}
+		// The reader has stopped when ValidateBatch returns
+		reads := r.reads.Load()
+		time.Sleep(20 * time.Millisecond)
+		if r.reads.Load() != reads {
+			t.Errorf("format %d: the input was read after ValidateBatch returned", format)
+		}
+	}
+}
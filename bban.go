@@ -0,0 +1,113 @@
+package iban
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Character classes used in the bbanFormat of a country.
+const (
+	classNumeric      = 'n' // Digits 0-9
+	classAlpha        = 'a' // Upper case letters A-Z
+	classAlphaNumeric = 'c' // Upper case letters and digits
+)
+
+// bbanSegment is a single run of characters of the same class, e.g. "8n".
+type bbanSegment struct {
+	length int  // The number of characters in the segment
+	class  byte // The character class of the segment
+}
+
+// String returns the segment in its bbanFormat notation.
+func (s bbanSegment) String() string {
+	return strconv.Itoa(s.length) + string(s.class)
+}
+
+// bbanStructure is the compiled form of a bbanFormat string.
+type bbanStructure []bbanSegment
+
+// FormatError is returned when a character of the BBAN does not match the
+// structure defined for its country.
+type FormatError struct {
+	Country  string // The country code of the IBAN
+	Segment  int    // The index (starting at 1) of the failing segment in the BBAN format
+	Format   string // The failing segment, e.g. "6n"
+	Position int    // The index (starting at 0) of the wrong character in the electronic IBAN
+	Char     byte   // The wrong character
+}
+
+// Error implements the error interface.
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("IBAN: character %q at position %d does not match segment %d (%s) of the %s BBAN format",
+		e.Char, e.Position, e.Segment, e.Format, e.Country)
+}
+
+// parseBBANFormat compiles a bbanFormat string such as "4a,6n,8c".
+// Spaces around the segments are ignored.
+func parseBBANFormat(format string) (bbanStructure, error) {
+	var structure bbanStructure
+	for _, part := range strings.Split(format, ",") {
+		part = strings.TrimSpace(part)
+		if len(part) < 2 {
+			return nil, fmt.Errorf("IBAN: invalid BBAN format segment <%s>", part)
+		}
+
+		class := part[len(part)-1]
+		if class != classNumeric && class != classAlpha && class != classAlphaNumeric {
+			return nil, fmt.Errorf("IBAN: unknown character class in BBAN format segment <%s>", part)
+		}
+
+		length, err := strconv.Atoi(part[:len(part)-1])
+		if err != nil || length <= 0 {
+			return nil, fmt.Errorf("IBAN: invalid length in BBAN format segment <%s>", part)
+		}
+		structure = append(structure, bbanSegment{length: length, class: class})
+	}
+	return structure, nil
+}
+
+// length returns the total number of characters described by the structure.
+func (s bbanStructure) length() int {
+	total := 0
+	for _, segment := range s {
+		total += segment.length
+	}
+	return total
+}
+
+// check verifies the BBAN of the given country against the structure.
+// The BBAN is expected to be upper case and to have the structure's length.
+func (s bbanStructure) check(countryCode, bban string) error {
+	offset := 0
+	for i, segment := range s {
+		for j := offset; j < offset+segment.length && j < len(bban); j++ {
+			if !matchesClass(bban[j], segment.class) {
+				return &FormatError{
+					Country:  countryCode,
+					Segment:  i + 1,
+					Format:   segment.String(),
+					Position: j + 4,
+					Char:     bban[j],
+				}
+			}
+		}
+		offset += segment.length
+	}
+	return nil
+}
+
+// matchesClass reports whether the character belongs to the given character class.
+func matchesClass(char, class byte) bool {
+	isDigit := char >= '0' && char <= '9'
+	isLetter := char >= 'A' && char <= 'Z'
+	switch class {
+	case classNumeric:
+		return isDigit
+	case classAlpha:
+		return isLetter
+	case classAlphaNumeric:
+		return isDigit || isLetter
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBBANFormatMatchesLength(t *testing.T) {
+	for code, country := range countryList {
+		if country.structure.length() != country.chars-4 {
+			t.Errorf("%s: BBAN format %q describes %d characters, expected %d",
+				code, country.bbanFormat, country.structure.length(), country.chars-4)
+		}
+	}
+}
+
+func TestParseBBANFormat(t *testing.T) {
+	structure, err := parseBBANFormat("4a, 6n,8c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := bbanStructure{{4, 'a'}, {6, 'n'}, {8, 'c'}}
+	if len(structure) != len(expected) {
+		t.Fatalf("got %v, expected %v", structure, expected)
+	}
+	for i := range expected {
+		if structure[i] != expected[i] {
+			t.Errorf("segment %d: got %v, expected %v", i, structure[i], expected[i])
+		}
+	}
+
+	for _, format := range []string{"", "4", "4x", "0n", "n", "4a,,6n"} {
+		if _, err := parseBBANFormat(format); err == nil {
+			t.Errorf("expected an error for format %q", format)
+		}
+	}
+}
+
+var bbanFormatTestNumbers = []struct {
+	number   string
+	segment  int
+	position int
+	char     byte
+}{
+	{"GB82 1EST 1234 5698 7654 32", 1, 4, '1'},
+	{"GB82 WEST 1234 5A98 7654 32", 2, 13, 'A'},
+	{"DE89 3704 0044 0532 0130 0X", 1, 21, 'X'},
+	{"NL02 ABNA 0123 4567 8B", 2, 17, 'B'},
+}
+
+func TestBBANFormatError(t *testing.T) {
+	for _, test := range bbanFormatTestNumbers {
+		ok, _, err := IsCorrectIban(test.number, false)
+		if ok {
+			t.Errorf("%s: expected the IBAN to be rejected", test.number)
+			continue
+		}
+
+		var formatErr *FormatError
+		if !errors.As(err, &formatErr) {
+			t.Errorf("%s: expected a FormatError, got %v", test.number, err)
+			continue
+		}
+		if formatErr.Segment != test.segment || formatErr.Position != test.position || formatErr.Char != test.char {
+			t.Errorf("%s: got segment %d position %d char %q, expected segment %d position %d char %q",
+				test.number, formatErr.Segment, formatErr.Position, formatErr.Char,
+				test.segment, test.position, test.char)
+		}
+	}
+}
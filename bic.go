@@ -0,0 +1,193 @@
+package iban
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidBIC is returned when an invalid BIC was received
+var ErrInvalidBIC = errors.New("invalid BIC received")
+
+// BIC is a Business Identifier Code (ISO 9362), also known as SWIFT code, e.g. "DEUTDEFF500".
+type BIC struct {
+	Code        string // The BIC in upper case without spaces, 8 or 11 characters
+	Institution string // The institution (bank) code, 4 characters
+	CountryCode string // The country code, 2 letters
+	Location    string // The location code, 2 characters
+	Branch      string // The branch code, 3 characters, empty for an 8 character BIC
+}
+
+// NewBIC validates the BIC and returns the BIC struct with its different parts filled in.
+// Spaces are removed and lower case letters are accepted. The country code must be one
+// of the supported IBAN countries (see SupportedCountryCodes) or a territory that uses the
+// IBANs of one of them, e.g. GP (Guadeloupe) for FR.
+func NewBIC(code string) (BIC, error) {
+	code = strings.ToUpper(strings.ReplaceAll(code, " ", ""))
+	if err := checkBIC(code); err != nil {
+		return BIC{}, err
+	}
+	bic := BIC{Code: code, Institution: code[:4], CountryCode: code[4:6], Location: code[6:8]}
+	if len(code) == 11 {
+		bic.Branch = code[8:]
+	}
+	return bic, nil
+}
+
+// Validate checks the BIC again, so a BIC that was not created by NewBIC is rejected if invalid.
+func (b BIC) Validate() error {
+	parsed, err := NewBIC(b.Code)
+	if err != nil {
+		return err
+	}
+	if parsed != b {
+		return fmt.Errorf("%w: the parts do not match the code <%s>", ErrInvalidBIC, b.Code)
+	}
+	return nil
+}
+
+// checkBIC checks the structure of a BIC in upper case without spaces.
+func checkBIC(code string) error {
+	if len(code) != 8 && len(code) != 11 {
+		return fmt.Errorf("%w: a BIC has 8 or 11 characters, got %d", ErrInvalidBIC, len(code))
+	}
+	for i := 0; i < len(code); i++ {
+		if !matchesClass(code[i], classAlphaNumeric) {
+			return fmt.Errorf("%w: illegal character %q at position %d", ErrInvalidBIC, code[i], i)
+		}
+	}
+
+	// The institution code may contain digits since ISO 9362:2014, so only the country code must be letters
+	countryCode := code[4:6]
+	if !matchesClass(countryCode[0], classAlpha) || !matchesClass(countryCode[1], classAlpha) {
+		return fmt.Errorf("%w: the country code <%s> is not two letters", ErrInvalidBIC, countryCode)
+	}
+	if _, exists := countryList[countryCode]; !exists && territories[countryCode] == "" {
+		return fmt.Errorf("%w: country <%s> is not in the list", ErrInvalidBIC, countryCode)
+	}
+
+	// The location code cannot start with 0 or 1, and its second character cannot be the letter O,
+	// which would be mistaken for the 0 of test BICs
+	if location := code[6:8]; location[0] == '0' || location[0] == '1' || location[1] == 'O' {
+		return fmt.Errorf("%w: invalid location code <%s>", ErrInvalidBIC, location)
+	}
+
+	// Branch codes starting with X are reserved, except XXX for the primary office
+	if len(code) == 11 {
+		if branch := code[8:]; branch[0] == 'X' && branch != "XXX" {
+			return fmt.Errorf("%w: invalid branch code <%s>", ErrInvalidBIC, branch)
+		}
+	}
+	return nil
+}
+
+// IsTest reports whether the BIC is a test and training BIC, whose location code ends with 0.
+func (b BIC) IsTest() bool {
+	return len(b.Location) == 2 && b.Location[1] == '0'
+}
+
+// IsPassive reports whether the BIC belongs to a passive participant, which is not connected
+// to the SWIFT network; its location code ends with 1.
+func (b BIC) IsPassive() bool {
+	return len(b.Location) == 2 && b.Location[1] == '1'
+}
+
+// IsPrimaryOffice reports whether the BIC identifies the primary office of the institution:
+// it has 8 characters or the branch code XXX.
+func (b BIC) IsPrimaryOffice() bool {
+	return b.Branch == "" || b.Branch == "XXX"
+}
+
+// String returns the BIC code.
+func (b BIC) String() string {
+	return b.Code
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (b BIC) MarshalText() ([]byte, error) {
+	return []byte(b.Code), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The BIC is validated with NewBIC.
+func (b *BIC) UnmarshalText(text []byte) error {
+	parsed, err := NewBIC(string(text))
+	if err != nil {
+		return err
+	}
+	*b = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The BIC is encoded as a string, the zero BIC as null.
+func (b BIC) MarshalJSON() ([]byte, error) {
+	if b == (BIC{}) {
+		return []byte("null"), nil
+	}
+	return json.Marshal(b.Code)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The BIC must be a JSON string and is validated
+// with NewBIC. null leaves the BIC unchanged.
+func (b *BIC) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return b.UnmarshalText([]byte(text))
+}
+
+// Value implements driver.Valuer. The zero BIC cannot be stored, use NullBIC for optional columns.
+func (b BIC) Value() (driver.Value, error) {
+	if b == (BIC{}) {
+		return nil, fmt.Errorf("%w: the zero BIC cannot be stored, use NullBIC for optional columns", ErrInvalidBIC)
+	}
+	return b.Code, nil
+}
+
+// Scan implements sql.Scanner. The stored value is validated with NewBIC and NULL is rejected.
+func (b *BIC) Scan(src any) error {
+	text, err := scanText(src, ErrInvalidBIC, "a BIC")
+	if err != nil {
+		return err
+	}
+	if text == nil {
+		return fmt.Errorf("%w: NULL cannot be scanned into a BIC, use NullBIC", ErrInvalidBIC)
+	}
+	return b.UnmarshalText(text)
+}
+
+// NullBIC represents a BIC that may be NULL, like sql.NullString.
+type NullBIC struct {
+	BIC   BIC
+	Valid bool // Valid is true if BIC is not NULL
+}
+
+// Value implements driver.Valuer.
+func (n NullBIC) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.BIC.Value()
+}
+
+// Scan implements sql.Scanner. A stored value that is not NULL is validated with NewBIC.
+func (n *NullBIC) Scan(src any) error {
+	text, err := scanText(src, ErrInvalidBIC, "a BIC")
+	if err != nil {
+		return err
+	}
+	if text == nil {
+		*n = NullBIC{}
+		return nil
+	}
+	if err := n.BIC.UnmarshalText(text); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
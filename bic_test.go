@@ -0,0 +1,104 @@
+package iban
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = (*BIC)(nil)
+	_ driver.Valuer = BIC{}
+	_ sql.Scanner   = (*NullBIC)(nil)
+	_ driver.Valuer = NullBIC{}
+)
+
+var bicTests = []struct {
+	code     string
+	expected BIC
+	test     bool
+	passive  bool
+	primary  bool
+}{
+	{"DEUTDEFF", BIC{"DEUTDEFF", "DEUT", "DE", "FF", ""}, false, false, true},
+	{"deut de ff 500", BIC{"DEUTDEFF500", "DEUT", "DE", "FF", "500"}, false, false, false},
+	{"NWBKGB2LXXX", BIC{"NWBKGB2LXXX", "NWBK", "GB", "2L", "XXX"}, false, false, true},
+	{"BNPAFRP0", BIC{"BNPAFRP0", "BNPA", "FR", "P0", ""}, true, false, true},
+	{"ABCDBEB1XXX", BIC{"ABCDBEB1XXX", "ABCD", "BE", "B1", "XXX"}, false, true, true},
+	{"1234NL2A", BIC{"1234NL2A", "1234", "NL", "2A", ""}, false, false, true},
+	{"BDAFGPGP", BIC{"BDAFGPGP", "BDAF", "GP", "GP", ""}, false, false, true},
+}
+
+func TestNewBIC(t *testing.T) {
+	for _, test := range bicTests {
+		bic, err := NewBIC(test.code)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.code, err)
+			continue
+		}
+		if bic != test.expected || bic.IsTest() != test.test || bic.IsPassive() != test.passive ||
+			bic.IsPrimaryOffice() != test.primary {
+			t.Errorf("%s: got %+v", test.code, bic)
+		}
+		if err := bic.Validate(); err != nil {
+			t.Errorf("%s: Validate failed: %v", test.code, err)
+		}
+	}
+}
+
+func TestInvalidBIC(t *testing.T) {
+	for _, code := range []string{"", "DEUTDEF", "DEUTDEFF5", "DEUTDEFF50000", "DEUT-EFF", "DEUT1EFF", "DEUTUSFF",
+		"DEUTXXFF", "DEUTDE0F", "DEUTDE1F", "DEUTDEFO", "DEUTDEFFXAB"} {
+		if _, err := NewBIC(code); !errors.Is(err, ErrInvalidBIC) {
+			t.Errorf("%q: expected ErrInvalidBIC, got %v", code, err)
+		}
+	}
+	if err := (BIC{Code: "DEUTDEFF", Institution: "DEUT"}).Validate(); !errors.Is(err, ErrInvalidBIC) {
+		t.Errorf("expected ErrInvalidBIC for parts not matching the code, got %v", err)
+	}
+}
+
+func TestBICEncoding(t *testing.T) {
+	var payment struct {
+		Creditor BIC `json:"creditor"`
+		Debtor   BIC `json:"debtor"`
+	}
+	if err := json.Unmarshal([]byte(`{"creditor":"deutdeff500","debtor":null}`), &payment); err != nil {
+		t.Fatal(err)
+	}
+	encoded, _ := json.Marshal(payment)
+	if string(encoded) != `{"creditor":"DEUTDEFF500","debtor":null}` {
+		t.Errorf("got %s", encoded)
+	}
+	if err := json.Unmarshal([]byte(`{"creditor":"DEUTDE"}`), &payment); !errors.Is(err, ErrInvalidBIC) {
+		t.Errorf("expected ErrInvalidBIC, got %v", err)
+	}
+}
+
+func TestBICScanAndValue(t *testing.T) {
+	var bic BIC
+	if err := bic.Scan([]byte("NWBKGB2L")); err != nil || bic.Institution != "NWBK" {
+		t.Errorf("got %+v (%v)", bic, err)
+	}
+	if stored, err := bic.Value(); err != nil || stored != "NWBKGB2L" {
+		t.Errorf("got %v (%v)", stored, err)
+	}
+	for _, src := range []any{nil, "NWBKGB2", 42} {
+		if err := bic.Scan(src); !errors.Is(err, ErrInvalidBIC) {
+			t.Errorf("%v: expected ErrInvalidBIC, got %v", src, err)
+		}
+	}
+
+	var optional NullBIC
+	if err := optional.Scan(nil); err != nil || optional.Valid {
+		t.Errorf("got %+v (%v)", optional, err)
+	}
+	if stored, err := optional.Value(); err != nil || stored != nil {
+		t.Errorf("got %v (%v)", stored, err)
+	}
+	if err := optional.Scan("NWBKGB2L"); err != nil || !optional.Valid || optional.BIC.CountryCode != "GB" {
+		t.Errorf("got %+v (%v)", optional, err)
+	}
+}
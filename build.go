@@ -0,0 +1,116 @@
+package iban
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Components are the national account details an IBAN is built from.
+// Every component is left-padded with zeros to the length of its field.
+type Components struct {
+	BankCode      string            // The bank code, e.g. the German BLZ or the British BIC bank code
+	Branch        string            // The branch code, e.g. the British sort code or the French code guichet
+	Account       string            // The account number
+	AccountType   string            // The account type, for the countries that have one
+	NationalCheck string            // The national check digits; computed if left empty and the country has a national check
+	Other         map[string]string // Other fields by their ibanFields letter, e.g. "m" for the currency
+}
+
+// fields returns the components keyed by their ibanFields letter.
+func (c Components) fields() map[string]string {
+	fields := make(map[string]string, len(c.Other)+5)
+	for letter, value := range c.Other {
+		fields[letter] = value
+	}
+	for letter, value := range map[byte]string{
+		fieldBank:          c.BankCode,
+		fieldBranch:        c.Branch,
+		fieldAccount:       c.Account,
+		fieldAccountType:   c.AccountType,
+		fieldNationalCheck: c.NationalCheck,
+	} {
+		if value != "" {
+			fields[string(letter)] = value
+		}
+	}
+	return fields
+}
+
+// Build creates a valid IBAN from national account details, e.g. a German BLZ and Kontonummer
+// or a British sort code and account number. The national check digits, if the country has a
+// national check, and the IBAN check digits are computed.
+func Build(countryCode string, components Components) (IBAN, error) {
+	return BuildFromFields(countryCode, components.fields())
+}
+
+// BuildFromFields creates a valid IBAN from its fields keyed by their ibanFields letter,
+// e.g. {"b": "37040044", "c": "532013000"} for Germany. Spaces and hyphens in the values are removed
+// and every value is left-padded with zeros to the length of its field.
+func BuildFromFields(countryCode string, fields map[string]string) (IBAN, error) {
+	countryCode = strings.ToUpper(countryCode)
+	country, exists := countryList[countryCode]
+	if !exists {
+		return IBAN{}, &ValidationError{Reason: UnknownCountry, Country: countryCode, Actual: countryCode, Position: 0}
+	}
+
+	for letter := range fields {
+		if len(letter) != 1 || letter[0] == fieldChecksum || len(country.fields[letter[0]]) == 0 {
+			return IBAN{}, fmt.Errorf("%w: country <%s> has no field <%s>", ErrInvalidComponent, countryCode, letter)
+		}
+	}
+
+	// Start with the literal characters of the layout, e.g. the reserved zeros of Turkey
+	number := []byte(strings.ReplaceAll(country.ibanFields, " ", ""))
+	copy(number, countryCode+"00")
+
+	check, hasCheck := lookupNationalCheck(countryCode)
+	letters := make([]byte, 0, len(country.fields))
+	for letter := range country.fields {
+		letters = append(letters, letter)
+	}
+	sort.Slice(letters, func(i, j int) bool { return letters[i] < letters[j] })
+
+	computeCheck := false
+	for _, letter := range letters {
+		if letter == fieldChecksum {
+			continue
+		}
+		positions := country.fields[letter]
+
+		value, given := fields[string(letter)]
+		value = strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(value))
+		if !given || value == "" {
+			if letter != fieldNationalCheck || !hasCheck {
+				return IBAN{}, fmt.Errorf("%w: field <%s> is required for country <%s>", ErrInvalidComponent, string(letter), countryCode)
+			}
+			value = strings.Repeat("0", len(positions))
+			computeCheck = true
+		}
+		if len(value) > len(positions) {
+			return IBAN{}, fmt.Errorf("%w: field <%s> has %d characters, country <%s> allows %d",
+				ErrInvalidComponent, string(letter), len(value), countryCode, len(positions))
+		}
+
+		value = strings.Repeat("0", len(positions)-len(value)) + value
+		for i, position := range positions {
+			number[position] = value[i]
+		}
+	}
+
+	// Compute the national check digits if they were not given
+	if computeCheck {
+		expected, err := check.Compute(string(number[4:]))
+		if err != nil {
+			return IBAN{}, fmt.Errorf("%w: %v", ErrInvalidComponent, err)
+		}
+		copy(number[4+check.Offset:], expected)
+	}
+
+	checksum, err := GetIbanChecksum(string(number))
+	if err != nil {
+		return IBAN{}, err
+	}
+	copy(number[2:], twoDigits(checksum))
+	return NewIBAN(string(number))
+}
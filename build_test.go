@@ -0,0 +1,63 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+var buildTestNumbers = []struct {
+	country    string
+	components Components
+	expected   string
+}{
+	{"DE", Components{BankCode: "37040044", Account: "532013000"}, "DE89 3704 0044 0532 0130 00"},
+	{"GB", Components{BankCode: "west", Branch: "12-34-56", Account: "98765432"}, "GB82 WEST 1234 5698 7654 32"},
+	{"FR", Components{BankCode: "20041", Branch: "01005", Account: "0500013M026"}, "FR14 2004 1010 0505 0001 3M02 606"},
+	{"FR", Components{BankCode: "20041", Branch: "01005", Account: "0500013M026", NationalCheck: "06"}, "FR14 2004 1010 0505 0001 3M02 606"},
+	{"FR", Components{BankCode: "20041", Branch: "01005", Account: "0500013M026", NationalCheck: " "}, "FR14 2004 1010 0505 0001 3M02 606"},
+	{"FR", Components{BankCode: "20041", Branch: "01005", Account: "0500013M026", NationalCheck: "-"}, "FR14 2004 1010 0505 0001 3M02 606"},
+	{"ES", Components{BankCode: "2100", Branch: "0418", Account: "200051332"}, "ES91 2100 0418 4502 0005 1332"},
+	{"IT", Components{BankCode: "05428", Branch: "11101", Account: "123456"}, "IT60 X054 2811 1010 0000 0123 456"},
+	{"BE", Components{BankCode: "539", Account: "75470"}, "BE68 5390 0754 7034"},
+	{"PT", Components{BankCode: "0002", Branch: "0000", Account: "00012345678"}, "PT50 0002 0000 0001 2345 6781 3"},
+	{"MU", Components{BankCode: "BOMM01", Branch: "01", Account: "123456789101", Other: map[string]string{"m": "MUR"}}, "MU43 BOMM 0101 1234 5678 9101 000M UR"},
+}
+
+func TestBuild(t *testing.T) {
+	for _, test := range buildTestNumbers {
+		result, err := Build(test.country, test.components)
+		if err != nil {
+			t.Errorf("%s %+v: %v", test.country, test.components, err)
+			continue
+		}
+		if result.Number != test.expected {
+			t.Errorf("%s %+v: got %s, expected %s", test.country, test.components, result.Number, test.expected)
+		}
+	}
+}
+
+func TestBuildFromFields(t *testing.T) {
+	result, err := BuildFromFields("de", map[string]string{"b": "37040044", "c": "0532013000"})
+	if err != nil || result.Number != "DE89 3704 0044 0532 0130 00" {
+		t.Errorf("got %s (%v)", result.Number, err)
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	if _, err := Build("XX", Components{}); !errors.Is(err, &ValidationError{Reason: UnknownCountry}) {
+		t.Errorf("expected UnknownCountry, got %v", err)
+	}
+	for _, fields := range []map[string]string{
+		{"b": "37040044"},                       // missing account number
+		{"b": "370400440", "c": "532013000"},    // bank code too long
+		{"b": "37040044", "c": "1", "s": "123"}, // no branch code in Germany
+		{"b": "37040044", "c": "1", "k": "89"},  // the IBAN check digits are computed
+	} {
+		if _, err := BuildFromFields("DE", fields); !errors.Is(err, ErrInvalidComponent) {
+			t.Errorf("%v: expected ErrInvalidComponent, got %v", fields, err)
+		}
+	}
+	if _, err := Build("FR", Components{BankCode: "20041", Branch: "01005", Account: "0500013M026", NationalCheck: "07"}); !errors.Is(err, &ValidationError{Reason: BadNationalCheckDigit}) {
+		t.Errorf("expected BadNationalCheckDigit, got %v", err)
+	}
+}
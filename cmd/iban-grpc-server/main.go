@@ -0,0 +1,39 @@
+// Command iban-grpc-server serves the IBAN rules of this package over gRPC, as defined in
+// ibanpb/iban.proto.
+//
+// Usage:
+//
+//	iban-grpc-server -addr :9090
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"github.com/go-pascal/iban"
+	"github.com/go-pascal/iban/ibanpb"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	addr := flag.String("addr", ":9090", "the address to listen on")
+	sepaOnly := flag.Bool("sepa-only", false, "only accept IBANs of SEPA countries")
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("iban-grpc-server: ")
+
+	var options []iban.Option
+	if *sepaOnly {
+		options = append(options, iban.WithSEPAOnly())
+	}
+
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	server := grpc.NewServer()
+	ibanpb.RegisterIBANServiceServer(server, newServer(iban.NewValidator(options...)))
+	log.Printf("listening on %s", listener.Addr())
+	log.Fatal(server.Serve(listener))
+}
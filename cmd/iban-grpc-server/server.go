@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/go-pascal/iban"
+	"github.com/go-pascal/iban/ibanpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// server implements the IBANService with a validator.
+type server struct {
+	ibanpb.UnimplementedIBANServiceServer
+	validator *iban.Validator
+}
+
+// newServer creates the IBANService; it uses the validator for all IBANs.
+func newServer(validator *iban.Validator) *server {
+	return &server{validator: validator}
+}
+
+func (s *server) Validate(ctx context.Context, request *ibanpb.ValidateRequest) (*ibanpb.ValidateResponse, error) {
+	return s.validate(request.GetIban()), nil
+}
+
+func (s *server) Parse(ctx context.Context, request *ibanpb.ParseRequest) (*ibanpb.ParseResponse, error) {
+	result, err := s.validator.Validate(request.GetIban())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	return &ibanpb.ParseResponse{Iban: toProto(result)}, nil
+}
+
+func (s *server) Build(ctx context.Context, request *ibanpb.BuildRequest) (*ibanpb.BuildResponse, error) {
+	result, err := iban.Build(request.GetCountryCode(), iban.Components{
+		BankCode:      request.GetBankCode(),
+		Branch:        request.GetBranch(),
+		Account:       request.GetAccount(),
+		AccountType:   request.GetAccountType(),
+		NationalCheck: request.GetNationalCheck(),
+		Other:         request.GetOther(),
+	})
+	if err == nil {
+		// The built IBAN must also pass the policies of the validator
+		result, err = s.validator.Validate(result.Number)
+	}
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	return &ibanpb.BuildResponse{Iban: toProto(result)}, nil
+}
+
+func (s *server) BatchValidate(stream grpc.BidiStreamingServer[ibanpb.ValidateRequest, ibanpb.ValidateResponse]) error {
+	for {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(s.validate(request.GetIban())); err != nil {
+			return err
+		}
+	}
+}
+
+// validate validates an IBAN and describes the result.
+func (s *server) validate(input string) *ibanpb.ValidateResponse {
+	result, err := s.validator.Validate(input)
+	if err != nil {
+		return &ibanpb.ValidateResponse{Input: input, Error: toProtoError(err)}
+	}
+	return &ibanpb.ValidateResponse{Input: input, Valid: true, Iban: toProto(result)}
+}
+
+// invalidArgument converts an error into an INVALID_ARGUMENT status; a rejected IBAN is
+// described by a ValidationError in the status details.
+func invalidArgument(err error) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+	var validationErr *iban.ValidationError
+	if errors.As(err, &validationErr) {
+		if detailed, detailsErr := st.WithDetails(toProtoError(err)); detailsErr == nil {
+			st = detailed
+		}
+	}
+	return st.Err()
+}
+
+// toProto converts an IBAN into its message.
+func toProto(i iban.IBAN) *ibanpb.IBAN {
+	return &ibanpb.IBAN{
+		Electronic:    i.Electronic(),
+		Formatted:     i.Number,
+		CountryCode:   i.CountryCode,
+		CheckDigits:   i.Checksum,
+		Bban:          i.BBAN,
+		BankCode:      i.BankCode,
+		Branch:        i.Branch,
+		Account:       i.Account,
+		NationalCheck: i.NationalCheck,
+		AccountType:   i.AccountType,
+		Fields:        i.Fields(),
+	}
+}
+
+// toProtoError converts a validation error into its message; other errors only have a message.
+func toProtoError(err error) *ibanpb.ValidationError {
+	message := &ibanpb.ValidationError{Position: -1, Message: err.Error()}
+	var validationErr *iban.ValidationError
+	if errors.As(err, &validationErr) {
+		// The values of ibanpb.Reason match iban.Reason
+		message.Reason = ibanpb.Reason(validationErr.Reason)
+		message.ReasonName = validationErr.Reason.String()
+		message.Country = validationErr.Country
+		message.Expected = validationErr.Expected
+		message.Actual = validationErr.Actual
+		message.Position = int32(validationErr.Position)
+	}
+	return message
+}
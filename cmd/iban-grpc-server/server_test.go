@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/go-pascal/iban"
+	"github.com/go-pascal/iban/ibanpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newClient starts the service with the validator on an in-process listener and returns a client.
+func newClient(t *testing.T, validator *iban.Validator) ibanpb.IBANServiceClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	ibanpb.RegisterIBANServiceServer(grpcServer, newServer(validator))
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return ibanpb.NewIBANServiceClient(conn)
+}
+
+func TestValidate(t *testing.T) {
+	client := newClient(t, iban.NewValidator())
+	ctx := context.Background()
+
+	response, err := client.Validate(ctx, &ibanpb.ValidateRequest{Iban: "gb82 west 1234 5698 7654 32"})
+	if err != nil || !response.GetValid() || response.GetIban().GetElectronic() != "GB82WEST12345698765432" ||
+		response.GetIban().GetBranch() != "123456" {
+		t.Errorf("got %v, %v", response, err)
+	}
+
+	response, err = client.Validate(ctx, &ibanpb.ValidateRequest{Iban: "GB83WEST12345698765432"})
+	if err != nil || response.GetValid() || response.GetError().GetReason() != ibanpb.Reason_REASON_BAD_CHECKSUM ||
+		response.GetError().GetReasonName() != "BadChecksum" || response.GetError().GetExpected() != "82" {
+		t.Errorf("got %v, %v", response, err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	client := newClient(t, iban.NewValidator())
+	ctx := context.Background()
+
+	response, err := client.Parse(ctx, &ibanpb.ParseRequest{Iban: "FR14 2004 1010 0505 0001 3M02 606"})
+	if err != nil || response.GetIban().GetBankCode() != "20041" || response.GetIban().GetNationalCheck() != "06" ||
+		response.GetIban().GetFields()["s"] != "01005" {
+		t.Errorf("got %v, %v", response, err)
+	}
+
+	_, err = client.Parse(ctx, &ibanpb.ParseRequest{Iban: "XX82WEST12345698765432"})
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument || len(st.Details()) != 1 {
+		t.Fatalf("expected INVALID_ARGUMENT with details, got %v", err)
+	}
+	if details, ok := st.Details()[0].(*ibanpb.ValidationError); !ok || details.GetReason() != ibanpb.Reason_REASON_UNKNOWN_COUNTRY {
+		t.Errorf("unexpected details %v", st.Details()[0])
+	}
+}
+
+func TestBuild(t *testing.T) {
+	client := newClient(t, iban.NewValidator(iban.WithSEPAOnly()))
+	ctx := context.Background()
+
+	response, err := client.Build(ctx, &ibanpb.BuildRequest{CountryCode: "DE", BankCode: "37040044", Account: "532013000"})
+	if err != nil || response.GetIban().GetElectronic() != "DE89370400440532013000" {
+		t.Errorf("got %v, %v", response, err)
+	}
+
+	if _, err = client.Build(ctx, &ibanpb.BuildRequest{CountryCode: "DE", BankCode: "37040044"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected INVALID_ARGUMENT for a missing account, got %v", err)
+	}
+
+	_, err = client.Build(ctx, &ibanpb.BuildRequest{CountryCode: "BR", BankCode: "00360305", Branch: "00001",
+		Account: "0009795493", AccountType: "C", Other: map[string]string{"n": "1"}})
+	details := status.Convert(err).Details()
+	if len(details) != 1 || details[0].(*ibanpb.ValidationError).GetReason() != ibanpb.Reason_REASON_NOT_SEPA {
+		t.Errorf("expected a NotSEPA rejection, got %v", err)
+	}
+}
+
+func TestBatchValidate(t *testing.T) {
+	client := newClient(t, iban.NewValidator())
+	stream, err := client.BatchValidate(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := []string{"GB82WEST12345698765432", "GB83WEST12345698765432", "DE89370400440532013000"}
+	for _, input := range inputs {
+		if err := stream.Send(&ibanpb.ValidateRequest{Iban: input}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stream.CloseSend()
+
+	for i, input := range inputs {
+		response, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.GetInput() != input || response.GetValid() != (i != 1) {
+			t.Errorf("response %d: got %v", i, response)
+		}
+	}
+}
+
+func TestReasonValues(t *testing.T) {
+	for _, reason := range iban.Reasons() {
+		if name := ibanpb.Reason(reason).String(); name == "" || ibanpb.Reason_value[name] != int32(reason) {
+			t.Errorf("reason %s has no matching ibanpb.Reason", reason)
+		}
+	}
+}
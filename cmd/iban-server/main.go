@@ -0,0 +1,44 @@
+// Command iban-server serves the IBAN rules of this package over a JSON REST API.
+//
+// Usage:
+//
+//	iban-server -addr :8080
+//
+// The endpoints validate, parse, format and compute the check digits of IBANs; the batch
+// endpoint validates up to 10000 IBANs at once. The OpenAPI document is served at /openapi.json.
+// Rejected IBANs and malformed requests get application/problem+json responses. The type of a
+// rejected IBAN is urn:iban:problem: followed by the reason code, e.g. urn:iban:problem:BadChecksum,
+// and the reason member holds the reason code; other problems have the type about:blank.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/go-pascal/iban"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "the address to listen on")
+	sepaOnly := flag.Bool("sepa-only", false, "only accept IBANs of SEPA countries")
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("iban-server: ")
+
+	var options []iban.Option
+	if *sepaOnly {
+		options = append(options, iban.WithSEPAOnly())
+	}
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           newServer(iban.NewValidator(options...)),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       time.Minute,
+		WriteTimeout:      time.Minute,
+	}
+	log.Printf("listening on %s", *addr)
+	log.Fatal(server.ListenAndServe())
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+)
+
+// openAPIDocument generates the OpenAPI 3.1 document of the routes. The schemas are derived
+// from the request and response types, so the document follows the code.
+func openAPIDocument(routes []route) map[string]any {
+	problemResponse := func(description string) map[string]any {
+		return map[string]any{
+			"description": description,
+			"content": map[string]any{
+				"application/problem+json": map[string]any{"schema": schemaOf(reflect.TypeOf(problem{}))},
+			},
+		}
+	}
+
+	paths := map[string]any{}
+	for _, r := range routes {
+		responses := map[string]any{
+			"200": map[string]any{
+				"description": "OK",
+				"content": map[string]any{
+					"application/json": map[string]any{"schema": schemaOf(reflect.TypeOf(r.response))},
+				},
+			},
+			"400": problemResponse("The request body is not valid JSON"),
+			"413": problemResponse("The request body is too large"),
+		}
+		if r.rejects {
+			responses["422"] = problemResponse("The IBAN was rejected; the type is one of " + strings.Join(problemTypes(), ", ") +
+				" and the reason member holds the reason code")
+		}
+		operation := map[string]any{
+			"summary":     r.summary,
+			"operationId": strings.TrimPrefix(r.path, "/v1/"),
+			"requestBody": map[string]any{
+				"required": true,
+				"content": map[string]any{
+					"application/json": map[string]any{"schema": schemaOf(reflect.TypeOf(r.request))},
+				},
+			},
+			"responses": responses,
+		}
+		paths[r.path] = map[string]any{strings.ToLower(r.method): operation}
+	}
+
+	return map[string]any{
+		"openapi": "3.1.0",
+		"info": map[string]any{
+			"title":   "IBAN validation",
+			"version": "1.0.0",
+		},
+		"paths": paths,
+	}
+}
+
+// schemaOf derives the JSON schema of a type from its fields, their json tags and their
+// description tags.
+func schemaOf(t reflect.Type) map[string]any {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return schemaOf(t.Elem())
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int:
+		return map[string]any{"type": "integer"}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": schemaOf(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": schemaOf(t.Elem())}
+	}
+
+	properties := map[string]any{}
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		property := schemaOf(field.Type)
+		if description := field.Tag.Get("description"); description != "" {
+			property["description"] = description
+		}
+		properties[name] = property
+		if options != "omitempty" {
+			required = append(required, name)
+		}
+	}
+	return map[string]any{"type": "object", "properties": properties, "required": required}
+}
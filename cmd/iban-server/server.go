@@ -0,0 +1,305 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-pascal/iban"
+)
+
+// The limits of the request bodies.
+const (
+	maxBodySize      = 64 << 10
+	maxBatchBodySize = 16 << 20
+	maxBatchSize     = 10000
+)
+
+// ibanRequest is the request body of the single IBAN endpoints.
+type ibanRequest struct {
+	IBAN string `json:"iban" description:"The IBAN in any format accepted by iban.Normalize"`
+}
+
+// batchRequest is the request body of the batch endpoint.
+type batchRequest struct {
+	IBANs []string `json:"ibans" description:"The IBANs to validate, at most 10000"`
+}
+
+// validation is the response body of the validate endpoint and one result of the batch endpoint.
+type validation struct {
+	Input    string `json:"input" description:"The IBAN as received"`
+	Valid    bool   `json:"valid"`
+	IBAN     string `json:"iban,omitempty" description:"The electronic format of a valid IBAN"`
+	Country  string `json:"country,omitempty" description:"The country code"`
+	Reason   string `json:"reason,omitempty" description:"The reason code of an invalid IBAN, e.g. BadChecksum"`
+	Position *int   `json:"position,omitempty" description:"The index of the offending character in the electronic IBAN"`
+	Detail   string `json:"detail,omitempty" description:"The validation error"`
+}
+
+// batchResponse is the response body of the batch endpoint.
+type batchResponse struct {
+	Results []validation `json:"results" description:"The results in the order of the request"`
+	Valid   int          `json:"valid"`
+	Invalid int          `json:"invalid"`
+}
+
+// parsed is the response body of the parse endpoint.
+type parsed struct {
+	IBAN          string            `json:"iban" description:"The electronic format"`
+	Formatted     string            `json:"formatted" description:"The print format, in groups of four characters"`
+	Country       string            `json:"country"`
+	CheckDigits   string            `json:"checkDigits"`
+	BBAN          string            `json:"bban"`
+	BankCode      string            `json:"bankCode,omitempty"`
+	Branch        string            `json:"branch,omitempty"`
+	Account       string            `json:"account,omitempty"`
+	AccountType   string            `json:"accountType,omitempty"`
+	NationalCheck string            `json:"nationalCheck,omitempty"`
+	Fields        map[string]string `json:"fields,omitempty" description:"All fields by their letter in the field layout of the country"`
+}
+
+// formatted is the response body of the format endpoint.
+type formatted struct {
+	Electronic string `json:"electronic" description:"Upper case and without spaces"`
+	Print      string `json:"print" description:"In groups of four characters"`
+}
+
+// checksum is the response body of the checksum endpoint.
+type checksum struct {
+	CheckDigits string `json:"checkDigits" description:"The IBAN check digits computed for the country code and BBAN"`
+}
+
+// problem is an RFC 9457 problem details response.
+type problem struct {
+	Type     string `json:"type" description:"urn:iban:problem: followed by the reason code for rejected IBANs, e.g. urn:iban:problem:BadChecksum; about:blank for other problems"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Reason   string `json:"reason,omitempty" description:"The reason code of a rejected IBAN, e.g. BadChecksum"`
+	Country  string `json:"country,omitempty"`
+	Position *int   `json:"position,omitempty" description:"The index of the offending character in the electronic IBAN"`
+}
+
+// server serves the REST API.
+type server struct {
+	validator *iban.Validator
+	mux       *http.ServeMux
+}
+
+// newServer creates the handler of the REST API; it uses the validator for all IBANs.
+func newServer(validator *iban.Validator) http.Handler {
+	s := &server{validator: validator, mux: http.NewServeMux()}
+	routes := s.routes()
+	for _, r := range routes {
+		s.mux.HandleFunc(r.path, allowMethod(r.method, r.handler))
+	}
+	document, _ := json.Marshal(openAPIDocument(routes))
+	s.mux.HandleFunc("/openapi.json", allowMethod(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(document)
+	}))
+	return s.mux
+}
+
+// allowMethod answers requests with another method than the given one with a problem.
+// The method is checked here rather than in the ServeMux pattern, so the routing does not
+// depend on the Go version the command is built with.
+func allowMethod(method string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			w.Header().Set("Allow", method)
+			writeProblem(w, problem{Title: http.StatusText(http.StatusMethodNotAllowed), Status: http.StatusMethodNotAllowed})
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// route is an endpoint of the REST API, described for the OpenAPI document.
+type route struct {
+	method   string
+	path     string
+	summary  string
+	request  any  // A value of the request body type
+	response any  // A value of the response body type
+	rejects  bool // Whether an invalid IBAN is answered with a problem
+	handler  http.HandlerFunc
+}
+
+// routes returns the endpoints of the REST API.
+func (s *server) routes() []route {
+	return []route{
+		{"POST", "/v1/validate", "Validate an IBAN; invalid IBANs are not an error", ibanRequest{}, validation{}, false, s.handleValidate},
+		{"POST", "/v1/parse", "Split a valid IBAN into its parts", ibanRequest{}, parsed{}, true, s.handleParse},
+		{"POST", "/v1/format", "Format a valid IBAN", ibanRequest{}, formatted{}, true, s.handleFormat},
+		{"POST", "/v1/checksum", "Compute the IBAN check digits", ibanRequest{}, checksum{}, true, s.handleChecksum},
+		{"POST", "/v1/batch", "Validate up to 10000 IBANs", batchRequest{}, batchResponse{}, false, s.handleBatch},
+	}
+}
+
+func (s *server) handleValidate(w http.ResponseWriter, r *http.Request) {
+	var request ibanRequest
+	if !decode(w, r, maxBodySize, &request) {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.validate(request.IBAN))
+}
+
+func (s *server) handleParse(w http.ResponseWriter, r *http.Request) {
+	var request ibanRequest
+	if !decode(w, r, maxBodySize, &request) {
+		return
+	}
+	result, err := s.validator.Validate(request.IBAN)
+	if err != nil {
+		writeValidationProblem(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, parsed{
+		IBAN:          result.Electronic(),
+		Formatted:     result.Number,
+		Country:       result.CountryCode,
+		CheckDigits:   result.Checksum,
+		BBAN:          result.BBAN,
+		BankCode:      result.BankCode,
+		Branch:        result.Branch,
+		Account:       result.Account,
+		AccountType:   result.AccountType,
+		NationalCheck: result.NationalCheck,
+		Fields:        result.Fields(),
+	})
+}
+
+func (s *server) handleFormat(w http.ResponseWriter, r *http.Request) {
+	var request ibanRequest
+	if !decode(w, r, maxBodySize, &request) {
+		return
+	}
+	result, err := s.validator.Validate(request.IBAN)
+	if err != nil {
+		writeValidationProblem(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, formatted{Electronic: result.Electronic(), Print: result.Number})
+}
+
+func (s *server) handleChecksum(w http.ResponseWriter, r *http.Request) {
+	var request ibanRequest
+	if !decode(w, r, maxBodySize, &request) {
+		return
+	}
+	digits, err := iban.GetIbanChecksum(request.IBAN)
+	if err != nil {
+		writeValidationProblem(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, checksum{CheckDigits: fmt.Sprintf("%02d", digits)})
+}
+
+func (s *server) handleBatch(w http.ResponseWriter, r *http.Request) {
+	var request batchRequest
+	if !decode(w, r, maxBatchBodySize, &request) {
+		return
+	}
+	if len(request.IBANs) > maxBatchSize {
+		writeProblem(w, problem{
+			Title:  http.StatusText(http.StatusRequestEntityTooLarge),
+			Status: http.StatusRequestEntityTooLarge,
+			Detail: fmt.Sprintf("a batch has at most %d IBANs, got %d", maxBatchSize, len(request.IBANs)),
+		})
+		return
+	}
+
+	response := batchResponse{Results: make([]validation, len(request.IBANs))}
+	for i, input := range request.IBANs {
+		response.Results[i] = s.validate(input)
+		if response.Results[i].Valid {
+			response.Valid++
+		} else {
+			response.Invalid++
+		}
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// validate validates an IBAN and describes the result.
+func (s *server) validate(input string) validation {
+	result, err := s.validator.Validate(input)
+	if err == nil {
+		return validation{Input: input, Valid: true, IBAN: result.Electronic(), Country: result.CountryCode}
+	}
+	v := validation{Input: input, Detail: err.Error()}
+	var validationErr *iban.ValidationError
+	if errors.As(err, &validationErr) {
+		v.Reason = validationErr.Reason.String()
+		v.Country = validationErr.Country
+		if validationErr.Position >= 0 {
+			v.Position = &validationErr.Position
+		}
+	}
+	return v
+}
+
+// decode decodes the JSON request body into v. It writes a problem and returns false if that fails.
+func decode(w http.ResponseWriter, r *http.Request, maxSize int64, v any) bool {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxSize))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(v)
+	if err == nil {
+		return true
+	}
+
+	status := http.StatusBadRequest
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		status = http.StatusRequestEntityTooLarge
+	}
+	writeProblem(w, problem{Title: http.StatusText(status), Status: status, Detail: "invalid request body: " + err.Error()})
+	return false
+}
+
+// problemTypePrefix is the prefix of the problem types of rejected IBANs; the reason code follows it.
+const problemTypePrefix = "urn:iban:problem:"
+
+// problemTypes returns the problem types of all reason codes.
+func problemTypes() []string {
+	var types []string
+	for _, reason := range iban.Reasons() {
+		types = append(types, problemTypePrefix+reason.String())
+	}
+	return types
+}
+
+// writeValidationProblem writes a rejected IBAN as a problem whose type is derived from its reason code.
+func writeValidationProblem(w http.ResponseWriter, err error) {
+	p := problem{Title: http.StatusText(http.StatusUnprocessableEntity), Status: http.StatusUnprocessableEntity, Detail: err.Error()}
+	var validationErr *iban.ValidationError
+	if errors.As(err, &validationErr) {
+		p.Type = problemTypePrefix + validationErr.Reason.String()
+		p.Title = "Invalid IBAN"
+		p.Reason = validationErr.Reason.String()
+		p.Country = validationErr.Country
+		if validationErr.Position >= 0 {
+			p.Position = &validationErr.Position
+		}
+	}
+	writeProblem(w, p)
+}
+
+// writeProblem writes an application/problem+json response. A problem without a type gets about:blank.
+func writeProblem(w http.ResponseWriter, p problem) {
+	if p.Type == "" {
+		p.Type = "about:blank"
+	}
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
+
+// writeJSON writes an application/json response.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-pascal/iban"
+)
+
+// post sends a POST request with the body to the handler and returns the response.
+func post(t *testing.T, handler http.Handler, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+	return recorder
+}
+
+// decodeBody decodes the JSON body of a response into v.
+func decodeBody(t *testing.T, recorder *httptest.ResponseRecorder, v any) {
+	t.Helper()
+	if err := json.Unmarshal(recorder.Body.Bytes(), v); err != nil {
+		t.Fatalf("decoding %q: %v", recorder.Body.String(), err)
+	}
+}
+
+func TestValidateEndpoint(t *testing.T) {
+	handler := newServer(iban.NewValidator())
+
+	var valid, invalid validation
+	decodeBody(t, post(t, handler, "/v1/validate", `{"iban":"gb82 west 1234 5698 7654 32"}`), &valid)
+	if !valid.Valid || valid.IBAN != "GB82WEST12345698765432" || valid.Country != "GB" {
+		t.Errorf("unexpected result %+v", valid)
+	}
+
+	recorder := post(t, handler, "/v1/validate", `{"iban":"GB83WEST12345698765432"}`)
+	decodeBody(t, recorder, &invalid)
+	if recorder.Code != http.StatusOK || invalid.Valid || invalid.Reason != "BadChecksum" || *invalid.Position != 2 {
+		t.Errorf("got status %d and result %+v", recorder.Code, invalid)
+	}
+}
+
+func TestParseEndpoint(t *testing.T) {
+	handler := newServer(iban.NewValidator())
+
+	var result parsed
+	recorder := post(t, handler, "/v1/parse", `{"iban":"DE89370400440532013000"}`)
+	decodeBody(t, recorder, &result)
+	if recorder.Code != http.StatusOK || result.BankCode != "37040044" || result.Account != "0532013000" ||
+		result.Formatted != "DE89 3704 0044 0532 0130 00" {
+		t.Errorf("got status %d and result %+v", recorder.Code, result)
+	}
+
+	var p problem
+	recorder = post(t, handler, "/v1/parse", `{"iban":"XX82WEST12345698765432"}`)
+	decodeBody(t, recorder, &p)
+	if recorder.Code != http.StatusUnprocessableEntity || recorder.Header().Get("Content-Type") != "application/problem+json" ||
+		p.Type != "urn:iban:problem:UnknownCountry" || p.Title != "Invalid IBAN" ||
+		p.Status != http.StatusUnprocessableEntity || p.Reason != "UnknownCountry" || p.Country != "XX" {
+		t.Errorf("got status %d and problem %+v", recorder.Code, p)
+	}
+}
+
+func TestFormatAndChecksumEndpoints(t *testing.T) {
+	handler := newServer(iban.NewValidator())
+
+	var f formatted
+	decodeBody(t, post(t, handler, "/v1/format", `{"iban":"IBAN: GB82-WEST-1234-5698-7654-32"}`), &f)
+	if f.Electronic != "GB82WEST12345698765432" || f.Print != "GB82 WEST 1234 5698 7654 32" {
+		t.Errorf("unexpected result %+v", f)
+	}
+
+	var c checksum
+	decodeBody(t, post(t, handler, "/v1/checksum", `{"iban":"BE00539007547034"}`), &c)
+	if c.CheckDigits != "68" {
+		t.Errorf("expected check digits 68, got %+v", c)
+	}
+}
+
+func TestBatchEndpoint(t *testing.T) {
+	handler := newServer(iban.NewValidator(iban.WithSEPAOnly()))
+
+	var response batchResponse
+	decodeBody(t, post(t, handler, "/v1/batch", `{"ibans":["GB82WEST12345698765432","BR1800360305000010009795493C1",""]}`), &response)
+	if len(response.Results) != 3 || response.Valid != 1 || response.Invalid != 2 ||
+		response.Results[1].Reason != "NotSEPA" || response.Results[2].Reason != "TooShort" {
+		t.Errorf("unexpected response %+v", response)
+	}
+
+	recorder := post(t, handler, "/v1/batch", `{"ibans":[`+strings.Repeat(`"",`, maxBatchSize)+`""]}`)
+	if recorder.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status %d, got %d", http.StatusRequestEntityTooLarge, recorder.Code)
+	}
+}
+
+func TestMalformedRequest(t *testing.T) {
+	handler := newServer(iban.NewValidator())
+	for _, body := range []string{`{"iban":`, `{"number":"GB82WEST12345698765432"}`, `[]`} {
+		var p problem
+		recorder := post(t, handler, "/v1/validate", body)
+		decodeBody(t, recorder, &p)
+		if recorder.Code != http.StatusBadRequest || p.Status != http.StatusBadRequest || p.Reason != "" ||
+			p.Type != "about:blank" || p.Title != "Bad Request" {
+			t.Errorf("%s: got status %d and problem %+v", body, recorder.Code, p)
+		}
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	newServer(iban.NewValidator()).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	var document struct {
+		OpenAPI string                               `json:"openapi"`
+		Paths   map[string]map[string]map[string]any `json:"paths"`
+	}
+	decodeBody(t, recorder, &document)
+	if document.OpenAPI != "3.1.0" || len(document.Paths) != 5 {
+		t.Fatalf("unexpected document %+v", document)
+	}
+	parse := document.Paths["/v1/parse"]["post"]
+	responses := parse["responses"].(map[string]any)
+	if rejected, exists := responses["422"].(map[string]any); !exists ||
+		!strings.Contains(rejected["description"].(string), "urn:iban:problem:BadChecksum") {
+		t.Errorf("expected a 422 response listing the problem types for /v1/parse, got %v", responses)
+	}
+	if _, exists := document.Paths["/v1/validate"]["post"]["responses"].(map[string]any)["422"]; exists {
+		t.Error("expected no 422 response for /v1/validate")
+	}
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	newServer(iban.NewValidator()).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/validate", nil))
+	if recorder.Code != http.StatusMethodNotAllowed || recorder.Header().Get("Allow") != http.MethodPost {
+		t.Errorf("got status %d and Allow %q", recorder.Code, recorder.Header().Get("Allow"))
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/go-pascal/iban"
+)
+
+// runBatch validates a column of a CSV or JSON Lines file and writes the annotated rows to stdout
+// and a summary to stderr.
+func runBatch(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := newFlagSet("batch", stderr)
+	format := flags.String("format", "csv", "the input format: csv or jsonl")
+	column := flags.String("column", "iban", "the CSV column or JSON field holding the IBAN")
+	comma := flags.String("comma", ",", "the CSV field separator")
+	workers := flags.Int("workers", 0, "the number of concurrent validations (default the number of CPUs)")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	options := iban.BatchOptions{Column: *column, Workers: *workers}
+	switch *format {
+	case "csv":
+		options.Format = iban.BatchCSV
+	case "jsonl":
+		options.Format = iban.BatchJSONLines
+	default:
+		fmt.Fprintf(stderr, "iban batch: unknown format %q\n", *format)
+		return exitUsage
+	}
+	separator, size := utf8.DecodeRuneInString(*comma)
+	if size == 0 || size != len(*comma) {
+		fmt.Fprintf(stderr, "iban batch: the separator must be a single character, got %q\n", *comma)
+		return exitUsage
+	}
+	options.Comma = separator
+
+	input := stdin
+	switch flags.NArg() {
+	case 0:
+	case 1:
+		file, err := os.Open(flags.Arg(0))
+		if err != nil {
+			fmt.Fprintf(stderr, "iban batch: %v\n", err)
+			return exitUsage
+		}
+		defer file.Close()
+		input = file
+	default:
+		flags.Usage()
+		return exitUsage
+	}
+
+	summary, err := iban.ValidateBatch(context.Background(), input, stdout, options)
+	printSummary(stderr, summary)
+	if err != nil {
+		fmt.Fprintf(stderr, "iban batch: %v\n", err)
+		return exitUsage
+	}
+	if summary.Invalid > 0 {
+		return exitInvalid
+	}
+	return exitValid
+}
+
+// printSummary prints the counts of a batch, with the invalid IBANs by reason.
+func printSummary(w io.Writer, summary iban.BatchSummary) {
+	fmt.Fprintf(w, "rows: %d, valid: %d, invalid: %d\n", summary.Rows, summary.Valid, summary.Invalid)
+	reasons := make([]iban.Reason, 0, len(summary.ByReason))
+	for reason := range summary.ByReason {
+		reasons = append(reasons, reason)
+	}
+	sort.Slice(reasons, func(i, j int) bool { return reasons[i] < reasons[j] })
+	for _, reason := range reasons {
+		fmt.Fprintf(w, "  %s: %d\n", reason, summary.ByReason[reason])
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-pascal/iban"
+)
+
+// inspection is the output of the inspect command for one IBAN.
+type inspection struct {
+	Input         string            `json:"input"`
+	Valid         bool              `json:"valid"`
+	IBAN          string            `json:"iban,omitempty"`
+	Country       string            `json:"country,omitempty"`
+	CountryName   string            `json:"countryName,omitempty"`
+	SEPA          bool              `json:"sepa"`
+	CheckDigits   string            `json:"checkDigits,omitempty"`
+	BBAN          string            `json:"bban,omitempty"`
+	BankCode      string            `json:"bankCode,omitempty"`
+	Branch        string            `json:"branch,omitempty"`
+	Account       string            `json:"account,omitempty"`
+	AccountType   string            `json:"accountType,omitempty"`
+	NationalCheck string            `json:"nationalCheck,omitempty"`
+	Fields        map[string]string `json:"fields,omitempty"`
+	Domestic      string            `json:"domestic,omitempty"`
+	Checks        []check           `json:"checks"`
+	Suggestions   []string          `json:"suggestions,omitempty"`
+}
+
+// check is the outcome of one of the checks of an IBAN.
+type check struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// checkNames are the checks of an IBAN in the order they are made. A rejected IBAN
+// passed every check before the one of its reason.
+var checkNames = []struct {
+	name   string
+	reason iban.Reason
+}{
+	{"characters", iban.IllegalCharacter},
+	{"country", iban.UnknownCountry},
+	{"length", iban.WrongLength},
+	{"bban format", iban.BadBBANFormat},
+	{"fixed check digits", iban.BadFixedChecksum},
+	{"check digits", iban.BadChecksum},
+	{"national check digits", iban.BadNationalCheckDigit},
+}
+
+// inspect validates the input and collects its parts and the outcome of the checks.
+func inspect(input string) inspection {
+	parsed, err := iban.NewIBAN(input)
+	result := inspection{Input: input, Valid: err == nil}
+
+	var validationErr *iban.ValidationError
+	if err != nil && !errors.As(err, &validationErr) {
+		result.Checks = []check{{Name: "iban", Detail: err.Error()}}
+		return result
+	}
+
+	for _, c := range checkNames {
+		if validationErr != nil && validationErr.Reason == iban.TooShort {
+			result.Checks = append(result.Checks, check{Name: "length", Detail: err.Error()})
+			break
+		}
+		if validationErr != nil && validationErr.Reason == c.reason {
+			result.Checks = append(result.Checks, check{Name: c.name, Detail: err.Error()})
+			break
+		}
+		result.Checks = append(result.Checks, check{Name: c.name, Passed: true})
+	}
+
+	if err != nil {
+		result.Country = validationErr.Country
+		for _, suggestion := range iban.Suggest(input) {
+			result.Suggestions = append(result.Suggestions, suggestion.IBAN.Number)
+		}
+		return result
+	}
+
+	country, _ := iban.LookupCountry(parsed.CountryCode)
+	if !country.HasNationalCheck {
+		last := len(result.Checks) - 1
+		result.Checks[last].Passed = false
+		result.Checks[last].Detail = "not verified for this country"
+	}
+	result.IBAN = parsed.Number
+	result.Country = parsed.CountryCode
+	result.CountryName = country.Name
+	result.SEPA = country.SEPA
+	result.CheckDigits = parsed.Checksum
+	result.BBAN = parsed.BBAN
+	result.BankCode = parsed.BankCode
+	result.Branch = parsed.Branch
+	result.Account = parsed.Account
+	result.AccountType = parsed.AccountType
+	result.NationalCheck = parsed.NationalCheck
+	result.Fields = parsed.Fields()
+	if domestic, err := parsed.Domestic(); err == nil {
+		result.Domestic = domestic.Formatted
+	}
+	return result
+}
+
+// runInspect prints the parts of every IBAN and the outcome of its checks.
+func runInspect(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := newFlagSet("inspect", stderr)
+	asJSON := flags.Bool("json", false, "print one JSON object per IBAN")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	encoder := json.NewEncoder(stdout)
+	return forEachInput(flags.Args(), stdin, stderr, func(input string) bool {
+		result := inspect(input)
+		if *asJSON {
+			encoder.Encode(result)
+		} else {
+			printInspection(stdout, result)
+		}
+		return result.Valid
+	})
+}
+
+// printInspection prints an inspection as aligned "name: value" lines followed by an empty line.
+func printInspection(w io.Writer, result inspection) {
+	line := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(w, "%-28s %s\n", name+":", value)
+		}
+	}
+
+	line("Input", result.Input)
+	line("IBAN", result.IBAN)
+	if result.CountryName != "" {
+		sepa := "not SEPA"
+		if result.SEPA {
+			sepa = "SEPA"
+		}
+		line("Country", fmt.Sprintf("%s (%s, %s)", result.Country, result.CountryName, sepa))
+	} else {
+		line("Country", result.Country)
+	}
+	line("Check digits", result.CheckDigits)
+	line("BBAN", result.BBAN)
+	line("Bank code", result.BankCode)
+	line("Branch", result.Branch)
+	line("Account", result.Account)
+	line("Account type", result.AccountType)
+	line("National check digits", result.NationalCheck)
+	line("Domestic", result.Domestic)
+
+	for _, c := range result.Checks {
+		outcome := "ok"
+		if !c.Passed {
+			outcome = "FAILED"
+			if result.Valid {
+				outcome = "skipped"
+			}
+		}
+		if c.Detail != "" {
+			outcome += " (" + c.Detail + ")"
+		}
+		line("Check "+c.Name, outcome)
+	}
+	for _, suggestion := range result.Suggestions {
+		line("Did you mean", suggestion)
+	}
+	fmt.Fprintln(w)
+}
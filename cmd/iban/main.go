@@ -0,0 +1,256 @@
+// Command iban validates, formats and inspects IBANs in shell pipelines.
+//
+// Usage:
+//
+//	iban validate [-json] [iban ...]
+//	iban format [-electronic] [iban ...]
+//	iban inspect [-json] [iban ...]
+//	iban checksum [iban ...]
+//	iban countries [-json]
+//	iban batch [-format csv|jsonl] [-column iban] [-comma ,] [-workers n] [file]
+//
+// Without IBAN arguments, the IBANs are read from the standard input, one per line.
+// The exit code is 0 if all IBANs are valid, 1 if any IBAN is invalid and 2 on a usage
+// or input error.
+//
+// The batch command validates a column of a CSV or JSON Lines file, read from the standard
+// input if no file is given, and writes every row with the validation result added to the
+// standard output and a summary to the standard error.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/go-pascal/iban"
+)
+
+// The exit codes of the command.
+const (
+	exitValid   = 0 // All IBANs are valid
+	exitInvalid = 1 // At least one IBAN is invalid
+	exitUsage   = 2 // The command line or the input could not be read
+)
+
+// commands are the subcommands of the tool by name.
+var commands = map[string]func(args []string, stdin io.Reader, stdout, stderr io.Writer) int{
+	"validate":  runValidate,
+	"format":    runFormat,
+	"inspect":   runInspect,
+	"checksum":  runChecksum,
+	"countries": runCountries,
+	"batch":     runBatch,
+}
+
+// commandUsages are the usage lines of the subcommands, in the order of the usage message.
+var commandUsages = []struct {
+	name  string
+	usage string
+}{
+	{"validate", "validate [-json] [iban ...]"},
+	{"format", "format [-electronic] [iban ...]"},
+	{"inspect", "inspect [-json] [iban ...]"},
+	{"checksum", "checksum [iban ...]"},
+	{"countries", "countries [-json]"},
+	{"batch", "batch [-format csv|jsonl] [-column iban] [-comma ,] [-workers n] [file]"},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs the subcommand named by the first argument and returns the exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return exitUsage
+	}
+	command, exists := commands[args[0]]
+	if !exists {
+		fmt.Fprintf(stderr, "iban: unknown command %q\n", args[0])
+		usage(stderr)
+		return exitUsage
+	}
+	return command(args[1:], stdin, stdout, stderr)
+}
+
+// usage prints the usage of all commands.
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage:")
+	for _, command := range commandUsages {
+		fmt.Fprintf(w, "  iban %s\n", command.usage)
+	}
+	fmt.Fprintln(w, "Without IBAN arguments, the IBANs are read from the standard input, one per line.")
+}
+
+// newFlagSet creates the flag set of a subcommand.
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	flags := flag.NewFlagSet("iban "+name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		for _, command := range commandUsages {
+			if command.name == name {
+				fmt.Fprintf(stderr, "usage: iban %s\n", command.usage)
+			}
+		}
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+// forEachInput calls fn for every IBAN argument, or for every non-empty line of stdin
+// if there are none. It returns the exit code.
+func forEachInput(args []string, stdin io.Reader, stderr io.Writer, fn func(input string) bool) int {
+	code := exitValid
+	handle := func(input string) {
+		if !fn(input) {
+			code = exitInvalid
+		}
+	}
+
+	if len(args) > 0 {
+		for _, arg := range args {
+			handle(arg)
+		}
+		return code
+	}
+
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			handle(line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(stderr, "iban: %v\n", err)
+		return exitUsage
+	}
+	return code
+}
+
+// result is the JSON output of the validate command for one IBAN.
+type result struct {
+	Input    string `json:"input"`
+	Valid    bool   `json:"valid"`
+	IBAN     string `json:"iban,omitempty"`
+	Country  string `json:"country,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+	Position *int   `json:"position,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// newResult creates the result of the validation of an input.
+func newResult(input string, parsed iban.IBAN, err error) result {
+	if err == nil {
+		return result{Input: input, Valid: true, IBAN: parsed.Electronic(), Country: parsed.CountryCode}
+	}
+	r := result{Input: input, Error: err.Error()}
+	var validationErr *iban.ValidationError
+	if errors.As(err, &validationErr) {
+		r.Country = validationErr.Country
+		r.Reason = validationErr.Reason.String()
+		if validationErr.Position >= 0 {
+			r.Position = &validationErr.Position
+		}
+	}
+	return r
+}
+
+// runValidate prints whether every IBAN is valid.
+func runValidate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := newFlagSet("validate", stderr)
+	asJSON := flags.Bool("json", false, "print one JSON object per IBAN")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	encoder := json.NewEncoder(stdout)
+	return forEachInput(flags.Args(), stdin, stderr, func(input string) bool {
+		parsed, err := iban.NewIBAN(input)
+		switch {
+		case *asJSON:
+			encoder.Encode(newResult(input, parsed, err))
+		case err != nil:
+			fmt.Fprintf(stdout, "%s\tinvalid\t%v\n", input, err)
+		default:
+			fmt.Fprintf(stdout, "%s\tvalid\n", parsed.Number)
+		}
+		return err == nil
+	})
+}
+
+// runFormat prints every IBAN in its print or electronic format.
+func runFormat(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := newFlagSet("format", stderr)
+	electronic := flags.Bool("electronic", false, "print the electronic format instead of the print format")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	return forEachInput(flags.Args(), stdin, stderr, func(input string) bool {
+		parsed, err := iban.NewIBAN(input)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", input, err)
+			return false
+		}
+		if *electronic {
+			fmt.Fprintln(stdout, parsed.Electronic())
+		} else {
+			fmt.Fprintln(stdout, parsed.Number)
+		}
+		return true
+	})
+}
+
+// runChecksum prints the IBAN check digits computed for every IBAN.
+func runChecksum(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := newFlagSet("checksum", stderr)
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	return forEachInput(flags.Args(), stdin, stderr, func(input string) bool {
+		checksum, err := iban.GetIbanChecksum(input)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", input, err)
+			return false
+		}
+		fmt.Fprintf(stdout, "%02d\n", checksum)
+		return true
+	})
+}
+
+// runCountries prints the IBAN rules of all supported countries.
+func runCountries(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := newFlagSet("countries", stderr)
+	asJSON := flags.Bool("json", false, "print the full registry as a JSON array")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if flags.NArg() > 0 {
+		flags.Usage()
+		return exitUsage
+	}
+
+	countries := iban.Countries()
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(countries)
+		return exitValid
+	}
+	for _, country := range countries {
+		sepa := "no"
+		if country.SEPA {
+			sepa = "yes"
+		}
+		fmt.Fprintf(stdout, "%s\t%d\t%s\t%s\t%s\n", country.Code, country.Length, country.BBANFormat, sepa, country.Name)
+	}
+	return exitValid
+}
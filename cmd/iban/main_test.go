@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// runCommand runs the command with the arguments and standard input and returns its output and exit code.
+func runCommand(stdin string, args ...string) (string, string, int) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return stdout.String(), stderr.String(), code
+}
+
+func TestValidate(t *testing.T) {
+	stdout, _, code := runCommand("", "validate", "GB82 WEST 1234 5698 7654 32", "DE89370400440532013000")
+	if code != exitValid || stdout != "GB82 WEST 1234 5698 7654 32\tvalid\nDE89 3704 0044 0532 0130 00\tvalid\n" {
+		t.Errorf("got exit code %d and output %q", code, stdout)
+	}
+
+	stdout, _, code = runCommand("GB82WEST12345698765432\n\nGB83WEST12345698765432\n", "validate", "-json")
+	if code != exitInvalid {
+		t.Errorf("expected exit code %d, got %d", exitInvalid, code)
+	}
+	var results []result
+	decoder := json.NewDecoder(strings.NewReader(stdout))
+	for decoder.More() {
+		var r result
+		if err := decoder.Decode(&r); err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, r)
+	}
+	if len(results) != 2 || !results[0].Valid || results[1].Valid || results[1].Reason != "BadChecksum" ||
+		results[1].Position == nil || *results[1].Position != 2 {
+		t.Errorf("unexpected results %+v", results)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	stdout, _, code := runCommand("gb82 west 1234 5698 7654 32\n", "format", "-electronic")
+	if code != exitValid || stdout != "GB82WEST12345698765432\n" {
+		t.Errorf("got exit code %d and output %q", code, stdout)
+	}
+	_, stderr, code := runCommand("", "format", "GB83WEST12345698765432")
+	if code != exitInvalid || !strings.Contains(stderr, "GB83WEST12345698765432") {
+		t.Errorf("got exit code %d and error %q", code, stderr)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	stdout, _, code := runCommand("", "inspect", "DE89370400440532013000")
+	for _, expected := range []string{"Country:                     DE (Germany, SEPA)", "Bank code:                   37040044",
+		"Domestic:                    BLZ 370 400 44, Konto 532013000", "Check check digits:          ok"} {
+		if !strings.Contains(stdout, expected) {
+			t.Errorf("expected %q in output %q", expected, stdout)
+		}
+	}
+	if code != exitValid {
+		t.Errorf("expected exit code %d, got %d", exitValid, code)
+	}
+
+	stdout, _, code = runCommand("", "inspect", "-json", "GB82 WEST 1234 5698 7645 32")
+	var result inspection
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatal(err)
+	}
+	last := result.Checks[len(result.Checks)-1]
+	if code != exitInvalid || result.Valid || last.Name != "check digits" || last.Passed ||
+		len(result.Suggestions) == 0 || result.Suggestions[0] != "GB82 WEST 1234 5698 7654 32" {
+		t.Errorf("got exit code %d and result %+v", code, result)
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	stdout, _, code := runCommand("", "checksum", "GB00WEST12345698765432", "BE00539007547034")
+	if code != exitValid || stdout != "82\n68\n" {
+		t.Errorf("got exit code %d and output %q", code, stdout)
+	}
+}
+
+func TestCountries(t *testing.T) {
+	stdout, _, code := runCommand("", "countries")
+	if code != exitValid || !strings.Contains(stdout, "GB\t22\t4a,14n\tyes\tUnited Kingdom") {
+		t.Errorf("got exit code %d and output %q", code, stdout)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	for _, args := range [][]string{nil, {"unknown"}, {"validate", "-unknown"}} {
+		if _, stderr, code := runCommand("", args...); code != exitUsage || stderr == "" {
+			t.Errorf("%v: expected exit code %d with a message, got %d", args, exitUsage, code)
+		}
+	}
+}
+
+func TestBatch(t *testing.T) {
+	stdout, stderr, code := runCommand("name;iban\nAcme;GB82WEST12345698765432\nFoo;GB83WEST12345698765432\n", "batch", "-comma", ";")
+	if code != exitInvalid || !strings.HasPrefix(stdout, "name;iban;iban_normalized;") ||
+		stderr != "rows: 2, valid: 1, invalid: 1\n  BadChecksum: 1\n" {
+		t.Errorf("got exit code %d, output %q and summary %q", code, stdout, stderr)
+	}
+
+	stdout, _, code = runCommand(`{"iban":"GB82WEST12345698765432"}`+"\n", "batch", "-format", "jsonl")
+	if code != exitValid || !strings.Contains(stdout, `"iban_valid":true`) {
+		t.Errorf("got exit code %d and output %q", code, stdout)
+	}
+
+	if _, _, code = runCommand("account\n", "batch"); code != exitUsage {
+		t.Errorf("expected exit code %d for a missing column, got %d", exitUsage, code)
+	}
+}
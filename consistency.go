@@ -0,0 +1,95 @@
+package iban
+
+// Consistency tells whether a BIC belongs to the bank of an IBAN.
+type Consistency int
+
+// The results of CheckConsistency.
+const (
+	ConsistencyUnknown Consistency = iota // The bank of the IBAN is not in the directories, or its BIC is unknown
+	Consistent                            // The BIC matches the IBAN
+	CountryMismatch                       // The BIC is of another country than the IBAN
+	BankMismatch                          // The BIC is of another bank than the one the directories give for the bank code
+)
+
+var consistencyNames = map[Consistency]string{
+	ConsistencyUnknown: "unknown",
+	Consistent:         "consistent",
+	CountryMismatch:    "country-mismatch",
+	BankMismatch:       "bank-mismatch",
+}
+
+// String returns the name of the result, e.g. "country-mismatch".
+func (c Consistency) String() string {
+	if name, exists := consistencyNames[c]; exists {
+		return name
+	}
+	return "unknown"
+}
+
+// territories maps the country codes of territories to the country whose IBANs and BICs their
+// banks may use, e.g. a bank in Réunion may have a BIC with country code RE and FR IBANs.
+var territories = map[string]string{
+	"GF": "FR", // French Guiana
+	"GP": "FR", // Guadeloupe
+	"MQ": "FR", // Martinique
+	"RE": "FR", // Réunion
+	"YT": "FR", // Mayotte
+	"BL": "FR", // Saint Barthélemy
+	"MF": "FR", // Saint Martin
+	"PM": "FR", // Saint Pierre and Miquelon
+	"NC": "FR", // New Caledonia
+	"PF": "FR", // French Polynesia
+	"WF": "FR", // Wallis and Futuna
+	"TF": "FR", // French Southern Territories
+	"GG": "GB", // Guernsey
+	"JE": "GB", // Jersey
+	"IM": "GB", // Isle of Man
+	"AX": "FI", // Åland Islands
+	"SJ": "NO", // Svalbard and Jan Mayen
+}
+
+// paymentCountry returns the country whose IBANs and BICs the banks of the country may use.
+func paymentCountry(countryCode string) string {
+	if country, exists := territories[countryCode]; exists {
+		return country
+	}
+	return countryCode
+}
+
+// CheckConsistency checks whether the BIC belongs to the bank of the IBAN. The country of the BIC
+// must be the country of the IBAN, where territories and their country are the same country
+// (e.g. a GP BIC is consistent with a FR IBAN). If directories are given, the bank code of the IBAN
+// is looked up in them in order, and the BIC must match the BIC of the bank: the branch code is not
+// compared, as the directories mostly hold the BIC of the primary office, and neither is the location
+// code of a BIC of a territory (e.g. BNPAREAX matches BNPAFRPP). Entries without a valid BIC are
+// skipped. Without directories, a BIC of the same country is consistent.
+func CheckConsistency(i IBAN, b BIC, directories ...BankDirectory) Consistency {
+	if b.Code == "" || i.CountryCode == "" {
+		return ConsistencyUnknown
+	}
+	if paymentCountry(b.CountryCode) != paymentCountry(i.CountryCode) {
+		return CountryMismatch
+	}
+	if len(directories) == 0 {
+		return Consistent
+	}
+
+	for _, directory := range directories {
+		bank, found := directory.Lookup(i)
+		if !found || bank.BIC == "" {
+			continue
+		}
+		expected, err := NewBIC(bank.BIC)
+		if err != nil {
+			continue
+		}
+		if expected.Institution != b.Institution || paymentCountry(expected.CountryCode) != paymentCountry(b.CountryCode) {
+			return BankMismatch
+		}
+		if expected.CountryCode == b.CountryCode && expected.Location != b.Location {
+			return BankMismatch
+		}
+		return Consistent
+	}
+	return ConsistencyUnknown
+}
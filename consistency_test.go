@@ -0,0 +1,60 @@
+package iban
+
+import "testing"
+
+func TestCheckConsistency(t *testing.T) {
+	directory := NewDirectory([]Bank{
+		{Country: "DE", BankCode: "37040044", Name: "Commerzbank", BIC: "COBADEFFXXX"},
+		{Country: "FR", BankCode: "20041", Name: "La Banque Postale"},
+	})
+	tests := []struct {
+		iban        string
+		bic         string
+		directories []BankDirectory
+		expected    Consistency
+	}{
+		{"DE89370400440532013000", "DEUTDEFF", nil, Consistent},
+		{"DE89370400440532013000", "NWBKGB2L", nil, CountryMismatch},
+		{"FR1420041010050500013M02606", "BDAFGPGP", nil, Consistent},
+		{"FR1420041010050500013M02606", "BNPAREAX", nil, Consistent},
+		{"GB82WEST12345698765432", "RBOSGGSX", nil, Consistent},
+		{"GB82WEST12345698765432", "BNPAFRPP", nil, CountryMismatch},
+		{"DE89370400440532013000", "COBADEFF", []BankDirectory{directory}, Consistent},
+		{"DE89370400440532013000", "COBADEFF370", []BankDirectory{directory}, Consistent},
+		{"DE89370400440532013000", "DEUTDEFF", []BankDirectory{directory}, BankMismatch},
+		{"DE89370400440532013000", "NWBKGB2L", []BankDirectory{directory}, CountryMismatch},
+		{"FR1420041010050500013M02606", "PSSTFRPP", []BankDirectory{directory}, ConsistencyUnknown},
+		{"GB82WEST12345698765432", "NWBKGB2L", []BankDirectory{directory}, ConsistencyUnknown},
+		{"GB82WEST12345698765432", "NWBKGB2L", []BankDirectory{directory, NewDirectory([]Bank{
+			{Country: "GB", BankCode: "WEST", Name: "Westminster", BIC: "NWBKGB2L"},
+		})}, Consistent},
+		{"GB82WEST12345698765432", "NWBKGB2L", []BankDirectory{NewDirectory([]Bank{
+			{Country: "GB", BankCode: "WEST", Name: "Westminster", BIC: "NOT A BIC"},
+		}), NewDirectory([]Bank{
+			{Country: "GB", BankCode: "WEST", Name: "Westminster", BIC: "NWBKGB2L"},
+		})}, Consistent},
+		{"FR1420041010050500013M02606", "BNPAREAX", []BankDirectory{NewDirectory([]Bank{
+			{Country: "FR", BankCode: "20041", Name: "BNP Paribas", BIC: "BNPAFRPP"},
+		})}, Consistent},
+		{"FR1420041010050500013M02606", "BNPAREAX", []BankDirectory{NewDirectory([]Bank{
+			{Country: "FR", BankCode: "20041", Name: "La Banque Postale", BIC: "PSSTFRPP"},
+		})}, BankMismatch},
+	}
+	for _, test := range tests {
+		iban, err := NewIBAN(test.iban)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bic, err := NewBIC(test.bic)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result := CheckConsistency(iban, bic, test.directories...); result != test.expected {
+			t.Errorf("%s %s: got %s, expected %s", test.iban, test.bic, result, test.expected)
+		}
+	}
+
+	if result := CheckConsistency(IBAN{}, BIC{}); result != ConsistencyUnknown {
+		t.Errorf("got %s for zero values", result)
+	}
+}
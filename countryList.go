@@ -1,115 +1,116 @@
+// Code generated by gencountrylist from data/countries.csv; DO NOT EDIT.
+
 package iban
 
 var countryList = map[string]ibanCountry{
-	"AD": {country: "Andorra", chars: 24, bbanFormat: "8n,12c", code: "AD", ibanFields: "ADkk bbbb ssss cccc cccc cccc", comment: "b = National bank code s = Branch code c = Account number", standardTreatment: true},
-	"AE": {country: "United Arab Emirates", chars: 23, bbanFormat: "3n,16n", code: "AE", ibanFields: "AEkk bbbc cccc cccc cccc ccc", comment: "b = National bank code c = Account number ", standardTreatment: true},
-	"AL": {country: "Albania", chars: 28, bbanFormat: "8n, 16c", code: "AL", ibanFields: "ALkk bbbs sssx cccc cccc cccc cccc", comment: "b = National bank code s = Branch code x = National check digit c = Account number", standardTreatment: true},
-	"AO": {country: "Angola", chars: 25, bbanFormat: "21n", code: "AO", ibanFields: "AOkk bbbb cccc cccc cccc cccx", comment: "b = Bank code; c = Account number; x = Check digit", standardTreatment: true},
-	"AT": {country: "Austria", chars: 20, bbanFormat: "16n", code: "AT", ibanFields: "ATkk bbbb bccc cccc cccc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"AZ": {country: "Azerbaijan", chars: 28, bbanFormat: "4c,20n", code: "AZ", ibanFields: "AZkk bbbb cccc cccc cccc cccc cccc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"BA": {country: "Bosnia and Herzegovina", chars: 20, bbanFormat: "16n", code: "BA", ibanFields: "BAkk bbbs sscc cccc ccxx", comment: "k = IBAN check digits (always 39) b = National bank code s = Branch code c = Account number x = National check digits", standardTreatment: true},
-	"BE": {country: "Belgium", chars: 16, bbanFormat: "12n", code: "BE", ibanFields: "BEkk bbbc cccc ccxx", comment: "b = National bank code c = Account number x = National check digits", standardTreatment: true},
-	"BF": {country: "Burkina Faso", chars: 28, bbanFormat: "24n", code: "BF", ibanFields: "BFkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"BG": {country: "Bulgaria", chars: 22, bbanFormat: "4a,6n,8c", code: "BG", ibanFields: "BGkk bbbb ssss ddcc cccc cc", comment: "b = BIC bank code s = Branch (BAE) number d = Account type c = Account number", standardTreatment: true},
-	"BH": {country: "Bahrain", chars: 22, bbanFormat: "4a,14c", code: "BH", ibanFields: "BHkk bbbb cccc cccc cccc cc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"BI": {country: "Burundi", chars: 16, bbanFormat: "12n", code: "BI", ibanFields: "BIkk bbbb cccc cccc", comment: "b = Bank code; c = Account number", standardTreatment: true},
-	"BJ": {country: "Benin", chars: 28, bbanFormat: "24n", code: "BJ", ibanFields: "BJkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"BL": {country: "Saint Barthélemy", chars: 27, bbanFormat: "10n,11c,2n", code: "BL", ibanFields: "BLkk bbbb bsss sscc cccc cccc cxx", comment: "b = Bank code; s = Branch code; c = Account number; x = National check digits", standardTreatment: true},
-	"BR": {country: "Brazil", chars: 29, bbanFormat: "23n, 1a, 1c", code: "BR", ibanFields: "BRkk bbbb bbbb ssss sccc cccc ccct n", comment: "k = IBAN check digits (Calculated by MOD 97-10) b = National bank code s = Branch code c = Account Number t = Account type (Cheque account, Savings account etc.) n = Owner account number (1, 2 etc.)[31]", standardTreatment: true},
-	"BY": {country: "Belarus", chars: 28, bbanFormat: "4c,20n", code: "BY", ibanFields: "BYkk bbbb cccc cccc cccc cccc cccc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"CF": {country: "Central African Republic", chars: 27, bbanFormat: "23n", code: "CF", ibanFields: "CFkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"CG": {country: "Congo", chars: 27, bbanFormat: "23n", code: "CG", ibanFields: "CGkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"CH": {country: "Switzerland", chars: 21, bbanFormat: "5n,12c", code: "CH", ibanFields: "CHkk bbbb bccc cccc cccc c", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"CI": {country: "Ivory Coast", chars: 28, bbanFormat: "24n", code: "CI", ibanFields: "CIkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"CM": {country: "Cameroon", chars: 27, bbanFormat: "23n", code: "CM", ibanFields: "CMkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"CR": {country: "Costa Rica", chars: 22, bbanFormat: "17n", code: "CR", ibanFields: "CRkk bbbc cccc cccc cccc c", comment: "b = bank code c = Account number", standardTreatment: false},
-	"CV": {country: "Cape Verde", chars: 25, bbanFormat: "21n", code: "CV", ibanFields: "CVkk bbbb ssss cccc cccc cccx", comment: "b = Bank code; s = Branch code; c = Account number; x = Check digit", standardTreatment: true},
-	"CY": {country: "Cyprus", chars: 28, bbanFormat: "8n,16c", code: "CY", ibanFields: "CYkk bbbs ssss cccc cccc cccc cccc", comment: "b = National bank code s = Branch code c = Account number", standardTreatment: true},
-	"CZ": {country: "Czech Republic", chars: 24, bbanFormat: "20n", code: "CZ", ibanFields: "CZkk bbbb ssss sscc cccc cccc", comment: "b = National bank code s = Account number prefix c = Account number", standardTreatment: true},
-	"DE": {country: "Germany", chars: 22, bbanFormat: "18n", code: "DE", ibanFields: "DEkk bbbb bbbb cccc cccc cc", comment: "b = Bank and branch identifier (de:Bankleitzahl or BLZ) c = Account number", standardTreatment: true},
-	"DJ": {country: "Djibouti", chars: 27, bbanFormat: "23n", code: "DJ", ibanFields: "DJkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"DK": {country: "Denmark", chars: 18, bbanFormat: "14n", code: "DK", ibanFields: "DKkk bbbb cccc cccc cc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"DO": {country: "Dominican Republic", chars: 28, bbanFormat: "4a,20n", code: "DO", ibanFields: "DOkk bbbb cccc cccc cccc cccc cccc", comment: "b = Bank identifier c = Account number", standardTreatment: true},
-	"DZ": {country: "Algeria", chars: 26, bbanFormat: "20n", code: "DZ", ibanFields: "DZkk bbbb ssss cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"EE": {country: "Estonia", chars: 20, bbanFormat: "16n", code: "EE", ibanFields: "EEkk bbss cccc cccc cccx", comment: "b = National bank code s = Branch code c = Account number x = National check digit", standardTreatment: true},
-	"EG": {country: "Egypt", chars: 29, bbanFormat: "25n", code: "EG", ibanFields: "EGkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"ES": {country: "Spain", chars: 24, bbanFormat: "20n", code: "ES", ibanFields: "ESkk bbbb gggg xxcc cccc cccc", comment: "b = National bank code g = Branch code x = Check digits c = Account number", standardTreatment: true},
-	"FI": {country: "Finland", chars: 18, bbanFormat: "14n", code: "FI", ibanFields: "FIkk bbbb bbcc cccc cx", comment: "b = Bank and branch code c = Account number x = National check digit", standardTreatment: true},
-	"FO": {country: "Faroe Islands", chars: 18, bbanFormat: "14n", code: "FO", ibanFields: "FOkk bbbb cccc cccc cx", comment: "b = National bank code c = Account number x = National check digit", standardTreatment: true},
-	"FR": {country: "France", chars: 27, bbanFormat: "10n,11c,2n", code: "FR", ibanFields: "FRkk bbbb bggg ggcc cccc cccc cxx", comment: "b = National bank code g = Branch code (fr:code guichet) c = Account number x = National check digits (fr:clé RIB)", standardTreatment: true},
-	"GA": {country: "Gabon", chars: 27, bbanFormat: "23n", code: "GA", ibanFields: "GAkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"GB": {country: "United Kingdom", chars: 22, bbanFormat: "4a,14n", code: "GB", ibanFields: "GBkk bbbb ssss sscc cccc cc", comment: "b = BIC bank code s = Bank and branch code (sort code) c = Account number", standardTreatment: true},
-	"GE": {country: "Georgia", chars: 22, bbanFormat: "2c,16n", code: "GE", ibanFields: "GEkk bbcc cccc cccc cccc cc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"GG": {country: "Guernsey", chars: 22, bbanFormat: "4a,14n", code: "GG", ibanFields: "GGkk bbbb ssss sscc cccc cc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"GI": {country: "Gibraltar", chars: 23, bbanFormat: "4a,15c", code: "GI", ibanFields: "GIkk bbbb cccc cccc cccc ccc", comment: "b = BIC bank code c = Account number", standardTreatment: true},
-	"GL": {country: "Greenland", chars: 18, bbanFormat: "14n", code: "GL", ibanFields: "GLkk bbbb cccc cccc cc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"GQ": {country: "Equatorial Guinea", chars: 27, bbanFormat: "23n", code: "GQ", ibanFields: "GQkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"GR": {country: "Greece", chars: 27, bbanFormat: "7n,16c", code: "GR", ibanFields: "GRkk bbbs sssc cccc cccc cccc ccc", comment: "b = National bank code s = Branch code c = Account number", standardTreatment: true},
-	"GT": {country: "Guatemala", chars: 28, bbanFormat: "4c,20c", code: "GT", ibanFields: "GTkk bbbb mmtt cccc cccc cccc cccc", comment: "b = National bank code c = Account number m = Currency t = Account type ", standardTreatment: true},
-	"GW": {country: "Guinea Bissau", chars: 25, bbanFormat: "21n", code: "GW", ibanFields: "GWkk bbbb ssss cccc cccc cccx", comment: "b = Bank code; s = Branch code; c = Account number; x = Check digit", standardTreatment: true},
-	"HN": {country: "Honduras", chars: 28, bbanFormat: "24n", code: "HN", ibanFields: "HNkk pppp cccc cccc cccc cccc cccc", comment: "p = Bank identifier code; c = Account number", standardTreatment: true},
-	"HR": {country: "Croatia", chars: 21, bbanFormat: "17n", code: "HR", ibanFields: "HRkk bbbb bbbc cccc cccc c", comment: "b = Bank code c = Account number", standardTreatment: true},
-	"HU": {country: "Hungary", chars: 28, bbanFormat: "24n", code: "HU", ibanFields: "HUkk bbbs sssk cccc cccc cccc cccx", comment: "b = National bank code s = Branch code c = Account number x = National check digit", standardTreatment: true},
-	"IE": {country: "Ireland", chars: 22, bbanFormat: "4c,14n", code: "IE", ibanFields: "IEkk aaaa bbbb bbcc cccc cc", comment: "a = BIC bank code b = Bank/branch code (sort code) c = Account number", standardTreatment: true},
-	"IK": {country: "Israel", chars: 23, bbanFormat: "19n", code: "IK", ibanFields: "ILkk bbbn nncc cccc cccc ccc", comment: "b = National bank code n = Branch number c = Account number 13 digits (padded with zeros)", standardTreatment: true},
-	"IL": {country: "Israel", chars: 23, bbanFormat: "4c,15n", code: "IL", ibanFields: "ILkk bbbb cccc cccc cccc ccc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"IM": {country: "Isle of Man", chars: 22, bbanFormat: "4a,14n", code: "IM", ibanFields: "IMkk bbbb ssss sscc cccc cc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"IQ": {country: "Iraq", chars: 23, bbanFormat: "4c,15n", code: "IQ", ibanFields: "IQkk bbbb cccc cccc cccc ccc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"IR": {country: "Iran", chars: 26, bbanFormat: "22n", code: "IR", ibanFields: "IRkk pppp cccc cccc cccc cccc cc", comment: "p = Bank code; c = Account number", standardTreatment: true},
-	"IS": {country: "Iceland", chars: 26, bbanFormat: "22n", code: "IS", ibanFields: "ISkk bbbb sscc cccc iiii iiii ii", comment: "b = National bank code s = Branch code c = Account number i = holder's kennitala (national identification number).", standardTreatment: true},
-	"IT": {country: "Italy", chars: 27, bbanFormat: "1a,10n,12c", code: "IT", ibanFields: "ITkk xaaa aabb bbbc cccc cccc ccc", comment: "x = Check char (CIN) a = National bank code (it:Associazione bancaria italiana or Codice ABI ) b = Branch code (it:Coordinate bancarie or CAB – Codice d'Avviamento Bancario) c = Account number", standardTreatment: true},
-	"JE": {country: "Jersey", chars: 22, bbanFormat: "4a,14n", code: "JE", ibanFields: "JEkk bbbb ssss sscc cccc cc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"JO": {country: "Jordan", chars: 30, bbanFormat: "4a, 22n", code: "JO", ibanFields: "JOkk bbbb nnnn cccc cccc cccc cccc cc", comment: "b = National bank code n = Branch code c = Account number ", standardTreatment: true},
-	"KM": {country: "Comoros", chars: 27, bbanFormat: "23n", code: "KM", ibanFields: "KMkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"KW": {country: "Kuwait", chars: 30, bbanFormat: "4a, 22c", code: "KW", ibanFields: "KWkk bbbb cccc cccc cccc cccc cccc cc", comment: "b = National bank code c = Account number.", standardTreatment: true},
-	"KZ": {country: "Kazakhstan", chars: 20, bbanFormat: "3n,13c", code: "KZ", ibanFields: "KZkk bbbc cccc cccc cccc", comment: "b = National bank code c = Account number ", standardTreatment: true},
-	"LB": {country: "Lebanon", chars: 28, bbanFormat: "4n,20c", code: "LB", ibanFields: "LBkk bbbb cccc cccc cccc cccc cccc", comment: "b = Bank code; c = Account number", standardTreatment: true},
-	"LC": {country: "Saint Lucia", chars: 32, bbanFormat: "4c,24n", code: "LC", ibanFields: "LCkk bbbb cccc cccc cccc ccc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"LI": {country: "Liechtenstein", chars: 21, bbanFormat: "5n,12c", code: "LI", ibanFields: "LIkk bbbb bccc cccc cccc c", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"LT": {country: "Lithuania", chars: 20, bbanFormat: "16n", code: "LT", ibanFields: "LTkk bbbb bccc cccc cccc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"LU": {country: "Luxembourg", chars: 20, bbanFormat: "3n,13c", code: "LU", ibanFields: "LUkk bbbc cccc cccc cccc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"LV": {country: "Latvia", chars: 21, bbanFormat: "4a,13c", code: "LV", ibanFields: "LVkk bbbb cccc cccc cccc c", comment: "b = BIC Bank code c = Account number", standardTreatment: true},
-	"MA": {country: "Morocco", chars: 28, bbanFormat: "24n", code: "MA", ibanFields: "MAkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"MC": {country: "Monaco", chars: 27, bbanFormat: "10n,11c,2n", code: "MC", ibanFields: "MCkk bbbb bsss sscc cccc cccc cxx", comment: "b = National bank code s = Branch code (fr:code guichet) c = Account number x = National check digits (fr:clé RIB). ", standardTreatment: true},
-	"MD": {country: "Moldova", chars: 24, bbanFormat: "2c,18c", code: "MD", ibanFields: "MDkk bbcc cccc cccc cccc cccc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"ME": {country: "Montenegro", chars: 22, bbanFormat: "18n", code: "ME", ibanFields: "MEkk bbbc cccc cccc cccc xx", comment: "k = IBAN check digits (always = '25') b = Bank code c = Account number x = National check digits", standardTreatment: true},
-	"MF": {country: "Saint Martin", chars: 27, bbanFormat: "10n,11c,2n", code: "MF", ibanFields: "MFkk bbbb bsss sscc cccc cccc cxx", comment: "b = Bank code; s =  Branch code; c = Account number; x = National check digits", standardTreatment: true},
-	"MG": {country: "Madagascar", chars: 27, bbanFormat: "23n", code: "MG", ibanFields: "MGkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"MK": {country: "Macedonia", chars: 19, bbanFormat: "3n,10c,2n", code: "MK", ibanFields: "MKkk bbbc cccc cccc cxx", comment: "k = IBAN check digits (always = '07') b = National bank code c = Account number x = National check digits", standardTreatment: true},
-	"ML": {country: "Mali", chars: 28, bbanFormat: "24n", code: "ML", ibanFields: "MLkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"MR": {country: "Mauritania", chars: 27, bbanFormat: "23n", code: "MR", ibanFields: "MRkk bbbb bsss sscc cccc cccc cxx", comment: "k = IBAN check digits (always 13) b = National bank code s = Branch code (fr:code guichet) c = Account number x = National check digits (fr:clé RIB)", standardTreatment: true},
-	"MT": {country: "Malta", chars: 31, bbanFormat: "4a,5n,18c", code: "MT", ibanFields: "MTkk bbbb ssss sccc cccc cccc cccc ccc", comment: "b = BIC bank code s = Branch code c = Account number", standardTreatment: true},
-	"MU": {country: "Mauritius", chars: 30, bbanFormat: "4a,19n,3a", code: "MU", ibanFields: "MUkk bbbb bbss cccc cccc cccc 000d dd", comment: "b = National bank code s = Branch identifier c = Account number 0 = Zeroes d = Currency Symbol ", standardTreatment: true},
-	"MZ": {country: "Mozambique", chars: 25, bbanFormat: "21n", code: "MZ", ibanFields: "MZkk bbbb ssss cccc cccc cccx x", comment: "b = Bank code; s = Branch code; c = Account number; x = Check digit", standardTreatment: true},
-	"NE": {country: "Niger", chars: 28, bbanFormat: "24n", code: "NE", ibanFields: "NEkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"NI": {country: "Nicaragua", chars: 32, bbanFormat: "28n", code: "NI", ibanFields: "NIkk bbbb ssss cccc cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"NL": {country: "Netherlands", chars: 18, bbanFormat: "4a,10n", code: "NL", ibanFields: "NLkk bbbb cccc cccc cc", comment: "b = BIC Bank code c = Account number", standardTreatment: true},
-	"NO": {country: "Norway", chars: 15, bbanFormat: "11n", code: "NO", ibanFields: "NOkk bbbb cccc ccx", comment: "b = National bank code c = Account number x = Modulo-11 national check digit", standardTreatment: true},
-	"PK": {country: "Pakistan", chars: 24, bbanFormat: "4c,16n", code: "PK", ibanFields: "PKkk bbbb cccc cccc cccc cccc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"PL": {country: "Poland", chars: 28, bbanFormat: "24n", code: "PL", ibanFields: "PLkk bbbs sssx cccc cccc cccc cccc", comment: "b = National bank code s = Branch code x = National check digit c = Account number, ", standardTreatment: true},
-	"PS": {country: "Palestinian territories", chars: 29, bbanFormat: "4c,21n", code: "PS", ibanFields: "PSkk bbbb xxxx xxxx xccc cccc cccc c", comment: "b = National bank code c = Account number x = Not specified", standardTreatment: true},
-	"PT": {country: "Portugal", chars: 25, bbanFormat: "21n", code: "PT", ibanFields: "PTkk bbbb ssss cccc cccc cccx x", comment: "k = IBAN check digits (always = '50') b = National bank code s = Branch code C = Account number x = National check digit", standardTreatment: true},
-	"QA": {country: "Qatar", chars: 29, bbanFormat: "4a, 21c", code: "QA", ibanFields: "QAkk bbbb cccc cccc cccc cccc cccc c", comment: "b = National bank code c = Account number[34]", standardTreatment: true},
-	"RE": {country: "Réunion", chars: 27, bbanFormat: "10n,11c,2n", code: "RE", ibanFields: "REkk bbbb bsss sscc cccc cccc cxx", comment: "b = Bank code; s = Branch code; c = Account number; x = National check digits", standardTreatment: true},
-	"RO": {country: "Romania", chars: 24, bbanFormat: "4a,16c", code: "RO", ibanFields: "ROkk bbbb cccc cccc cccc cccc", comment: "b = BIC Bank code c = Branch code and account number (bank-specific format) ", standardTreatment: true},
-	"RS": {country: "Serbia", chars: 22, bbanFormat: "18n", code: "RS", ibanFields: "RSkk bbbc cccc cccc cccc xx", comment: "b = National bank code c = Account number x = Account check digits", standardTreatment: true},
-	"SA": {country: "Saudi Arabia", chars: 24, bbanFormat: "2n,18c", code: "SA", ibanFields: "SAkk bbcc cccc cccc cccc cccc", comment: "b = National bank code c = Account number preceded by zeros, if required", standardTreatment: true},
-	"SC": {country: "Seychelles", chars: 31, bbanFormat: "4c,23n", code: "SC", ibanFields: "SCkk bbbb cccc cccc cccc cccc cccc mmm", comment: "b = National bank code c = Account number m = Currency", standardTreatment: true},
-	"SE": {country: "Sweden", chars: 24, bbanFormat: "20n", code: "SE", ibanFields: "SEkk bbbc cccc cccc cccc cccc", comment: "b = National bank code c = Account number ", standardTreatment: true},
-	"SI": {country: "Slovenia", chars: 19, bbanFormat: "15n", code: "SI", ibanFields: "SIkk bbss sccc cccc cxx", comment: "k = IBAN check digits (always = '56') b = National bank code s = Branch code c = Account number x = National check digits", standardTreatment: true},
-	"SK": {country: "Slovakia", chars: 24, bbanFormat: "20n", code: "SK", ibanFields: "SKkk bbbb ssss sscc cccc cccc", comment: "b = National bank code s = Account number prefix c = Account number", standardTreatment: true},
-	"SM": {country: "San Marino", chars: 27, bbanFormat: "1a,10n,12c", code: "SM", ibanFields: "SMkk xaaa aabb bbbc cccc cccc ccc", comment: "x = Check char (it:CIN) a = National bank code (it:Associazione bancaria italiana or Codice ABI) b = Branch code (it:Coordinate bancarie or CAB – Codice d'Avviamento Bancario) c = Account number", standardTreatment: true},
-	"SN": {country: "Senegal", chars: 28, bbanFormat: "24n", code: "SN", ibanFields: "SNkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"ST": {country: "Sao Tome and Principe", chars: 25, bbanFormat: "4c,17n", code: "ST", ibanFields: "STkk bbbb cccc cccc cccc cccc c", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"SV": {country: "El Salvador", chars: 28, bbanFormat: "4c,20n", code: "SV", ibanFields: "SVkk bbbb cccc cccc cccc cccc cccc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"TD": {country: "Chad", chars: 27, bbanFormat: "23n", code: "TD", ibanFields: "TDkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"TG": {country: "Togo", chars: 28, bbanFormat: "24n", code: "TG", ibanFields: "TGkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", standardTreatment: true},
-	"TL": {country: "East Timor", chars: 23, bbanFormat: "19n", code: "TL", ibanFields: "TLkk bbbc cccc cccc cccc cxx", comment: "k = IBAN check digits (always = '38') b = Bank identifier c = Account number x = National check digit", standardTreatment: true},
-	"TN": {country: "Tunisia", chars: 24, bbanFormat: "20n", code: "TN", ibanFields: "TNkk bbss sccc cccc cccc cccc", comment: "k = IBAN check digits (always 59) b = National bank code s = Branch code c = Account number", standardTreatment: true},
-	"TR": {country: "Turkey", chars: 26, bbanFormat: "5n,17c", code: "TR", ibanFields: "TRkk bbbb bxcc cccc cccc cccc cc", comment: "b = National bank code x = Reserved for future use (currently '0') c = Account number", standardTreatment: true},
-	"UA": {country: "Ukraine", chars: 29, bbanFormat: "4c,21n", code: "UA", ibanFields: "UAkk bbbb cccc cccc cccc cccc cccc c", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"VA": {country: "Vatican", chars: 22, bbanFormat: "3n,15n", code: "VA", ibanFields: "VAkk bbb cccc cccc cccc ccc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"VG": {country: "Virgin Islands, British", chars: 24, bbanFormat: "4c,16n", code: "VG", ibanFields: "VGkk bbbb cccc cccc cccc cccc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"XK": {country: "Kosovo", chars: 20, bbanFormat: "4n,10n,2n", code: "XK", ibanFields: "XKkk bbbb cccc cccc cccc", comment: "b = National bank code c = Account number", standardTreatment: true},
-	"YT": {country: "Mayotte", chars: 27, bbanFormat: "10n,11c,2n", code: "YT", ibanFields: "YTkk bbbb bsss sscc cccc cccc cxx", comment: "b = Bank code; s = Branch code; c = Account number; x = National check digits", standardTreatment: true},
+	"AD": {country: "Andorra", chars: 24, bbanFormat: "8n,12c", code: "AD", ibanFields: "ADkk bbbb ssss cccc cccc cccc", comment: "b = National bank code s = Branch code c = Account number", example: "AD1400080001001234567890", bbanExample: "00080001001234567890", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}, sepa: true},
+	"AE": {country: "United Arab Emirates", chars: 23, bbanFormat: "3n,16n", code: "AE", ibanFields: "AEkk bbbc cccc cccc cccc ccc", comment: "b = National bank code c = Account number", example: "AE460090000000123456789", bbanExample: "0090000000123456789", bankPosition: [2]int{1, 3}},
+	"AL": {country: "Albania", chars: 28, bbanFormat: "8n,16c", code: "AL", ibanFields: "ALkk bbbs sssx cccc cccc cccc cccc", comment: "b = National bank code s = Branch code x = National check digit c = Account number", example: "AL47212110090000000235698741", bbanExample: "212110090000000235698741", bankPosition: [2]int{1, 3}, branchPosition: [2]int{4, 7}, sepa: true},
+	"AO": {country: "Angola", chars: 25, bbanFormat: "21n", code: "AO", ibanFields: "AOkk bbbb ssss cccc cccc cccx x", comment: "b = Bank code; s = Branch code; c = Account number; x = National check digits", example: "AO06004400006729503010102", bbanExample: "004400006729503010102", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"AT": {country: "Austria", chars: 20, bbanFormat: "16n", code: "AT", ibanFields: "ATkk bbbb bccc cccc cccc", comment: "b = National bank code c = Account number", example: "AT483200000012345864", bbanExample: "3200000012345864", bankPosition: [2]int{1, 5}, sepa: true},
+	"AZ": {country: "Azerbaijan", chars: 28, bbanFormat: "4c,20n", code: "AZ", ibanFields: "AZkk bbbb cccc cccc cccc cccc cccc", comment: "b = National bank code c = Account number", example: "AZ96AZEJ00000000001234567890", bbanExample: "AZEJ00000000001234567890", bankPosition: [2]int{1, 4}},
+	"BA": {country: "Bosnia and Herzegovina", chars: 20, bbanFormat: "16n", code: "BA", ibanFields: "BAkk bbbs sscc cccc ccxx", comment: "k = IBAN check digits (always 39) b = National bank code s = Branch code c = Account number x = National check digits", fixedChecksum: "39", example: "BA391290079401028494", bbanExample: "1290079401028494", bankPosition: [2]int{1, 3}, branchPosition: [2]int{4, 6}},
+	"BE": {country: "Belgium", chars: 16, bbanFormat: "12n", code: "BE", ibanFields: "BEkk bbbc cccc ccxx", comment: "b = National bank code c = Account number x = National check digits", example: "BE71096123456769", bbanExample: "096123456769", bankPosition: [2]int{1, 3}, sepa: true},
+	"BF": {country: "Burkina Faso", chars: 28, bbanFormat: "2c,22n", code: "BF", ibanFields: "BFkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "BF42BF0840101300463574000390", bbanExample: "BF0840101300463574000390", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"BG": {country: "Bulgaria", chars: 22, bbanFormat: "4a,6n,8c", code: "BG", ibanFields: "BGkk bbbb ssss ttcc cccc cc", comment: "b = BIC bank code s = Branch (BAE) number t = Account type c = Account number", example: "BG18RZBB91550123456789", bbanExample: "RZBB91550123456789", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}, sepa: true},
+	"BH": {country: "Bahrain", chars: 22, bbanFormat: "4a,14c", code: "BH", ibanFields: "BHkk bbbb cccc cccc cccc cc", comment: "b = National bank code c = Account number", example: "BH02CITI00001077181611", bbanExample: "CITI00001077181611", bankPosition: [2]int{1, 4}},
+	"BI": {country: "Burundi", chars: 16, bbanFormat: "12n", code: "BI", ibanFields: "BIkk bbbb cccc cccc", comment: "b = Bank code; c = Account number", example: "BI43201011067444", bbanExample: "201011067444", bankPosition: [2]int{1, 4}},
+	"BJ": {country: "Benin", chars: 28, bbanFormat: "2c,22n", code: "BJ", ibanFields: "BJkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "BJ66BJ0610100100144390000769", bbanExample: "BJ0610100100144390000769", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"BL": {country: "Saint Barthélemy", chars: 27, bbanFormat: "10n,11c,2n", code: "BL", ibanFields: "BLkk bbbb bsss sscc cccc cccc cxx", comment: "b = Bank code; s = Branch code; c = Account number; x = National check digits", example: "BL6820041010050500013M02606", bbanExample: "20041010050500013M02606", bankPosition: [2]int{1, 5}, branchPosition: [2]int{6, 10}, sepa: true},
+	"BR": {country: "Brazil", chars: 29, bbanFormat: "23n,1a,1c", code: "BR", ibanFields: "BRkk bbbb bbbb ssss sccc cccc ccct n", comment: "k = IBAN check digits (Calculated by MOD 97-10) b = National bank code s = Branch code c = Account Number t = Account type (Cheque account, Savings account etc.) n = Owner account number (1, 2 etc.)", example: "BR1500000000000010932840814P2", bbanExample: "00000000000010932840814P2", bankPosition: [2]int{1, 8}, branchPosition: [2]int{9, 13}},
+	"BY": {country: "Belarus", chars: 28, bbanFormat: "4c,20n", code: "BY", ibanFields: "BYkk bbbb cccc cccc cccc cccc cccc", comment: "b = National bank code c = Account number", example: "BY86AKBB10100000002966000000", bbanExample: "AKBB10100000002966000000", bankPosition: [2]int{1, 4}},
+	"CF": {country: "Central African Republic", chars: 27, bbanFormat: "23n", code: "CF", ibanFields: "CFkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "CF4220001000010120069700160", bbanExample: "20001000010120069700160", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"CG": {country: "Congo", chars: 27, bbanFormat: "23n", code: "CG", ibanFields: "CGkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "CG3930011000101013451300019", bbanExample: "30011000101013451300019", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"CH": {country: "Switzerland", chars: 21, bbanFormat: "5n,12c", code: "CH", ibanFields: "CHkk bbbb bccc cccc cccc c", comment: "b = National bank code c = Account number", example: "CH5604835012345678009", bbanExample: "04835012345678009", bankPosition: [2]int{1, 5}, sepa: true},
+	"CI": {country: "Ivory Coast", chars: 28, bbanFormat: "2c,22n", code: "CI", ibanFields: "CIkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "CI93CI0080111301134291200589", bbanExample: "CI0080111301134291200589", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"CM": {country: "Cameroon", chars: 27, bbanFormat: "23n", code: "CM", ibanFields: "CMkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "CM2110002000300277976315008", bbanExample: "10002000300277976315008", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"CR": {country: "Costa Rica", chars: 22, bbanFormat: "18n", code: "CR", ibanFields: "CRkk 0bbb cccc cccc cccc cc", comment: "0 = Reserved (always 0) b = bank code c = Account number", example: "CR37012600000123456789", bbanExample: "012600000123456789", bankPosition: [2]int{2, 4}},
+	"CV": {country: "Cape Verde", chars: 25, bbanFormat: "21n", code: "CV", ibanFields: "CVkk bbbb ssss cccc cccc cccx x", comment: "b = Bank code; s = Branch code; c = Account number; x = National check digits", example: "CV64000500000020108215144", bbanExample: "000500000020108215144", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"CY": {country: "Cyprus", chars: 28, bbanFormat: "8n,16c", code: "CY", ibanFields: "CYkk bbbs ssss cccc cccc cccc cccc", comment: "b = National bank code s = Branch code c = Account number", example: "CY21002001950000357001234567", bbanExample: "002001950000357001234567", bankPosition: [2]int{1, 3}, branchPosition: [2]int{4, 8}, sepa: true},
+	"CZ": {country: "Czech Republic", chars: 24, bbanFormat: "20n", code: "CZ", ibanFields: "CZkk bbbb ssss sscc cccc cccc", comment: "b = National bank code s = Account number prefix c = Account number", example: "CZ5508000000001234567899", bbanExample: "08000000001234567899", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 10}, sepa: true},
+	"DE": {country: "Germany", chars: 22, bbanFormat: "18n", code: "DE", ibanFields: "DEkk bbbb bbbb cccc cccc cc", comment: "b = Bank and branch identifier (de:Bankleitzahl or BLZ) c = Account number", example: "DE91100000000123456789", bbanExample: "100000000123456789", bankPosition: [2]int{1, 8}, sepa: true},
+	"DJ": {country: "Djibouti", chars: 27, bbanFormat: "23n", code: "DJ", ibanFields: "DJkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "DJ2110002010010409943020008", bbanExample: "10002010010409943020008", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"DK": {country: "Denmark", chars: 18, bbanFormat: "14n", code: "DK", ibanFields: "DKkk bbbb cccc cccc cc", comment: "b = National bank code c = Account number", example: "DK9520000123456789", bbanExample: "20000123456789", bankPosition: [2]int{1, 4}, sepa: true},
+	"DO": {country: "Dominican Republic", chars: 28, bbanFormat: "4a,20n", code: "DO", ibanFields: "DOkk bbbb cccc cccc cccc cccc cccc", comment: "b = Bank identifier c = Account number", example: "DO22ACAU00000000000123456789", bbanExample: "ACAU00000000000123456789", bankPosition: [2]int{1, 4}},
+	"DZ": {country: "Algeria", chars: 26, bbanFormat: "22n", code: "DZ", ibanFields: "DZkk bbbb ssss cccc cccc cccc cc", comment: "b = Bank code; s = Branch code; c = Account number", example: "DZ580002100007113001511433", bbanExample: "0002100007113001511433", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"EE": {country: "Estonia", chars: 20, bbanFormat: "16n", code: "EE", ibanFields: "EEkk bbss cccc cccc cccx", comment: "b = National bank code s = Branch code c = Account number x = National check digit", example: "EE471000001020145685", bbanExample: "1000001020145685", bankPosition: [2]int{1, 2}, branchPosition: [2]int{3, 4}, sepa: true},
+	"EG": {country: "Egypt", chars: 29, bbanFormat: "25n", code: "EG", ibanFields: "EGkk bbbb ssss cccc cccc cccc cccc c", comment: "b = Bank code; s = Branch code; c = Account number", example: "EG210003700067100239218937900", bbanExample: "0003700067100239218937900", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"ES": {country: "Spain", chars: 24, bbanFormat: "20n", code: "ES", ibanFields: "ESkk bbbb ssss xxcc cccc cccc", comment: "b = National bank code s = Branch code x = Check digits c = Account number", example: "ES7921000813610123456789", bbanExample: "21000813610123456789", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}, sepa: true},
+	"FI": {country: "Finland", chars: 18, bbanFormat: "14n", code: "FI", ibanFields: "FIkk bbbb bbcc cccc cx", comment: "b = Bank and branch code c = Account number x = National check digit", example: "FI1410093000123458", bbanExample: "10093000123458", bankPosition: [2]int{1, 6}, sepa: true},
+	"FO": {country: "Faroe Islands", chars: 18, bbanFormat: "14n", code: "FO", ibanFields: "FOkk bbbb cccc cccc cx", comment: "b = National bank code c = Account number x = National check digit", example: "FO9264600123456789", bbanExample: "64600123456789", bankPosition: [2]int{1, 4}},
+	"FR": {country: "France", chars: 27, bbanFormat: "10n,11c,2n", code: "FR", ibanFields: "FRkk bbbb bsss sscc cccc cccc cxx", comment: "b = National bank code s = Branch code (fr:code guichet) c = Account number x = National check digits (fr:clé RIB)", example: "FR7630006000011234567890189", bbanExample: "30006000011234567890189", bankPosition: [2]int{1, 5}, branchPosition: [2]int{6, 10}, sepa: true},
+	"GA": {country: "Gabon", chars: 27, bbanFormat: "23n", code: "GA", ibanFields: "GAkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "GA2140021010032001890020126", bbanExample: "40021010032001890020126", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"GB": {country: "United Kingdom", chars: 22, bbanFormat: "4a,14n", code: "GB", ibanFields: "GBkk bbbb ssss sscc cccc cc", comment: "b = BIC bank code s = Bank and branch code (sort code) c = Account number", example: "GB98MIDL07009312345678", bbanExample: "MIDL07009312345678", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 10}, sepa: true},
+	"GE": {country: "Georgia", chars: 22, bbanFormat: "2c,16n", code: "GE", ibanFields: "GEkk bbcc cccc cccc cccc cc", comment: "b = National bank code c = Account number", example: "GE60NB0000000123456789", bbanExample: "NB0000000123456789", bankPosition: [2]int{1, 2}},
+	"GG": {country: "Guernsey", chars: 22, bbanFormat: "4a,14n", code: "GG", ibanFields: "GGkk bbbb ssss sscc cccc cc", comment: "b = Bank code; s = Branch code; c = Account number", example: "GG14NWBK60161331926819", bbanExample: "NWBK60161331926819", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 10}, sepa: true},
+	"GI": {country: "Gibraltar", chars: 23, bbanFormat: "4a,15c", code: "GI", ibanFields: "GIkk bbbb cccc cccc cccc ccc", comment: "b = BIC bank code c = Account number", example: "GI04BARC000001234567890", bbanExample: "BARC000001234567890", bankPosition: [2]int{1, 4}, sepa: true},
+	"GL": {country: "Greenland", chars: 18, bbanFormat: "14n", code: "GL", ibanFields: "GLkk bbbb cccc cccc cc", comment: "b = National bank code c = Account number", example: "GL8964710123456789", bbanExample: "64710123456789", bankPosition: [2]int{1, 4}},
+	"GQ": {country: "Equatorial Guinea", chars: 27, bbanFormat: "23n", code: "GQ", ibanFields: "GQkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "GQ7050002001003715228190196", bbanExample: "50002001003715228190196", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"GR": {country: "Greece", chars: 27, bbanFormat: "7n,16c", code: "GR", ibanFields: "GRkk bbbs sssc cccc cccc cccc ccc", comment: "b = National bank code s = Branch code c = Account number", example: "GR9608100010000001234567890", bbanExample: "08100010000001234567890", bankPosition: [2]int{1, 3}, branchPosition: [2]int{4, 7}, sepa: true},
+	"GT": {country: "Guatemala", chars: 28, bbanFormat: "4c,20c", code: "GT", ibanFields: "GTkk bbbb mmtt cccc cccc cccc cccc", comment: "b = National bank code c = Account number m = Currency t = Account type", example: "GT20AGRO00000000001234567890", bbanExample: "AGRO00000000001234567890", bankPosition: [2]int{1, 4}},
+	"GW": {country: "Guinea Bissau", chars: 25, bbanFormat: "2c,19n", code: "GW", ibanFields: "GWkk bbbb ssss cccc cccc cccx x", comment: "b = Bank code; s = Branch code; c = Account number; x = National check digits", example: "GW04GW1430010181800637601", bbanExample: "GW1430010181800637601", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"HN": {country: "Honduras", chars: 28, bbanFormat: "4a,20n", code: "HN", ibanFields: "HNkk bbbb cccc cccc cccc cccc cccc", comment: "b = Bank identifier code; c = Account number", example: "HN54PISA00000000000000123124", bbanExample: "PISA00000000000000123124", bankPosition: [2]int{1, 4}},
+	"HR": {country: "Croatia", chars: 21, bbanFormat: "17n", code: "HR", ibanFields: "HRkk bbbb bbbc cccc cccc c", comment: "b = Bank code c = Account number", example: "HR1723600001101234565", bbanExample: "23600001101234565", bankPosition: [2]int{1, 7}, sepa: true},
+	"HU": {country: "Hungary", chars: 28, bbanFormat: "24n", code: "HU", ibanFields: "HUkk bbbs sssx cccc cccc cccc cccx", comment: "b = National bank code s = Branch code x = National check digits c = Account number", example: "HU93116000060000000012345676", bbanExample: "116000060000000012345676", bankPosition: [2]int{1, 3}, branchPosition: [2]int{4, 7}, sepa: true},
+	"IE": {country: "Ireland", chars: 22, bbanFormat: "4c,14n", code: "IE", ibanFields: "IEkk bbbb ssss sscc cccc cc", comment: "b = BIC bank code s = Bank/branch code (sort code) c = Account number", example: "IE64IRCE92050112345678", bbanExample: "IRCE92050112345678", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 10}, sepa: true},
+	"IL": {country: "Israel", chars: 23, bbanFormat: "19n", code: "IL", ibanFields: "ILkk bbbs sscc cccc cccc ccc", comment: "b = National bank code s = Branch code c = Account number", example: "IL170108000000012612345", bbanExample: "0108000000012612345", bankPosition: [2]int{1, 3}, branchPosition: [2]int{4, 6}},
+	"IM": {country: "Isle of Man", chars: 22, bbanFormat: "4a,14n", code: "IM", ibanFields: "IMkk bbbb ssss sscc cccc cc", comment: "b = Bank code; s = Branch code; c = Account number", example: "IM75NWBK60161331926819", bbanExample: "NWBK60161331926819", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 10}, sepa: true},
+	"IQ": {country: "Iraq", chars: 23, bbanFormat: "4c,15n", code: "IQ", ibanFields: "IQkk bbbb cccc cccc cccc ccc", comment: "b = National bank code c = Account number", example: "IQ20CBIQ861800101010500", bbanExample: "CBIQ861800101010500", bankPosition: [2]int{1, 4}},
+	"IR": {country: "Iran", chars: 26, bbanFormat: "22n", code: "IR", ibanFields: "IRkk bbbb cccc cccc cccc cccc cc", comment: "b = Bank code; c = Account number", example: "IR710570029971601460641001", bbanExample: "0570029971601460641001", bankPosition: [2]int{1, 4}},
+	"IS": {country: "Iceland", chars: 26, bbanFormat: "22n", code: "IS", ibanFields: "ISkk bbbb sscc cccc iiii iiii ii", comment: "b = National bank code s = Branch code c = Account number i = holder's kennitala (national identification number).", example: "IS030001121234561234567890", bbanExample: "0001121234561234567890", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 6}, sepa: true},
+	"IT": {country: "Italy", chars: 27, bbanFormat: "1a,10n,12c", code: "IT", ibanFields: "ITkk xbbb bbss sssc cccc cccc ccc", comment: "x = Check char (CIN) b = National bank code (it:Associazione bancaria italiana or Codice ABI ) s = Branch code (it:Coordinate bancarie or CAB – Codice d'Avviamento Bancario) c = Account number", example: "IT60X0542811101000000123456", bbanExample: "X0542811101000000123456", bankPosition: [2]int{2, 6}, branchPosition: [2]int{7, 11}, sepa: true},
+	"JE": {country: "Jersey", chars: 22, bbanFormat: "4a,14n", code: "JE", ibanFields: "JEkk bbbb ssss sscc cccc cc", comment: "b = Bank code; s = Branch code; c = Account number", example: "JE90NWBK60161331926819", bbanExample: "NWBK60161331926819", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 10}, sepa: true},
+	"JO": {country: "Jordan", chars: 30, bbanFormat: "4a,22n", code: "JO", ibanFields: "JOkk bbbb ssss cccc cccc cccc cccc cc", comment: "b = National bank code s = Branch code c = Account number", example: "JO71CBJO0000000000001234567890", bbanExample: "CBJO0000000000001234567890", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"KM": {country: "Comoros", chars: 27, bbanFormat: "23n", code: "KM", ibanFields: "KMkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "KM4600005000010010904400137", bbanExample: "00005000010010904400137", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"KW": {country: "Kuwait", chars: 30, bbanFormat: "4a,22c", code: "KW", ibanFields: "KWkk bbbb cccc cccc cccc cccc cccc cc", comment: "b = National bank code c = Account number.", example: "KW81CBKU0000000000001234560101", bbanExample: "CBKU0000000000001234560101", bankPosition: [2]int{1, 4}},
+	"KZ": {country: "Kazakhstan", chars: 20, bbanFormat: "3n,13c", code: "KZ", ibanFields: "KZkk bbbc cccc cccc cccc", comment: "b = National bank code c = Account number", example: "KZ563190000012344567", bbanExample: "3190000012344567", bankPosition: [2]int{1, 3}},
+	"LB": {country: "Lebanon", chars: 28, bbanFormat: "4n,20c", code: "LB", ibanFields: "LBkk bbbb cccc cccc cccc cccc cccc", comment: "b = Bank code; c = Account number", example: "LB92000700000000123123456123", bbanExample: "000700000000123123456123", bankPosition: [2]int{1, 4}},
+	"LC": {country: "Saint Lucia", chars: 32, bbanFormat: "4c,24n", code: "LC", ibanFields: "LCkk bbbb cccc cccc cccc cccc cccc cccc", comment: "b = National bank code c = Account number", example: "LC14BOSL123456789012345678901234", bbanExample: "BOSL123456789012345678901234", bankPosition: [2]int{1, 4}},
+	"LI": {country: "Liechtenstein", chars: 21, bbanFormat: "5n,12c", code: "LI", ibanFields: "LIkk bbbb bccc cccc cccc c", comment: "b = National bank code c = Account number", example: "LI7408806123456789012", bbanExample: "08806123456789012", bankPosition: [2]int{1, 5}, sepa: true},
+	"LT": {country: "Lithuania", chars: 20, bbanFormat: "16n", code: "LT", ibanFields: "LTkk bbbb bccc cccc cccc", comment: "b = National bank code c = Account number", example: "LT601010012345678901", bbanExample: "1010012345678901", bankPosition: [2]int{1, 5}, sepa: true},
+	"LU": {country: "Luxembourg", chars: 20, bbanFormat: "3n,13c", code: "LU", ibanFields: "LUkk bbbc cccc cccc cccc", comment: "b = National bank code c = Account number", example: "LU120010001234567891", bbanExample: "0010001234567891", bankPosition: [2]int{1, 3}, sepa: true},
+	"LV": {country: "Latvia", chars: 21, bbanFormat: "4a,13c", code: "LV", ibanFields: "LVkk bbbb cccc cccc cccc c", comment: "b = BIC Bank code c = Account number", example: "LV97HABA0012345678910", bbanExample: "HABA0012345678910", bankPosition: [2]int{1, 4}, sepa: true},
+	"MA": {country: "Morocco", chars: 28, bbanFormat: "24n", code: "MA", ibanFields: "MAkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "MA64011519000001205000534921", bbanExample: "011519000001205000534921", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"MC": {country: "Monaco", chars: 27, bbanFormat: "10n,11c,2n", code: "MC", ibanFields: "MCkk bbbb bsss sscc cccc cccc cxx", comment: "b = National bank code s = Branch code (fr:code guichet) c = Account number x = National check digits (fr:clé RIB).", example: "MC5810096180790123456789085", bbanExample: "10096180790123456789085", bankPosition: [2]int{1, 5}, branchPosition: [2]int{6, 10}, sepa: true},
+	"MD": {country: "Moldova", chars: 24, bbanFormat: "2c,18c", code: "MD", ibanFields: "MDkk bbcc cccc cccc cccc cccc", comment: "b = National bank code c = Account number", example: "MD21EX000000000001234567", bbanExample: "EX000000000001234567", bankPosition: [2]int{1, 2}, sepa: true},
+	"ME": {country: "Montenegro", chars: 22, bbanFormat: "18n", code: "ME", ibanFields: "MEkk bbbc cccc cccc cccc xx", comment: "k = IBAN check digits (always = '25') b = Bank code c = Account number x = National check digits", fixedChecksum: "25", example: "ME25505000012345678951", bbanExample: "505000012345678951", bankPosition: [2]int{1, 3}, sepa: true},
+	"MF": {country: "Saint Martin", chars: 27, bbanFormat: "10n,11c,2n", code: "MF", ibanFields: "MFkk bbbb bsss sscc cccc cccc cxx", comment: "b = Bank code; s =  Branch code; c = Account number; x = National check digits", example: "MF8420041010050500013M02606", bbanExample: "20041010050500013M02606", bankPosition: [2]int{1, 5}, branchPosition: [2]int{6, 10}, sepa: true},
+	"MG": {country: "Madagascar", chars: 27, bbanFormat: "23n", code: "MG", ibanFields: "MGkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "MG4600005030071289421016045", bbanExample: "00005030071289421016045", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"MK": {country: "Macedonia", chars: 19, bbanFormat: "3n,10c,2n", code: "MK", ibanFields: "MKkk bbbc cccc cccc cxx", comment: "k = IBAN check digits (always = '07') b = National bank code c = Account number x = National check digits", fixedChecksum: "07", example: "MK07200002785123453", bbanExample: "200002785123453", bankPosition: [2]int{1, 3}, sepa: true},
+	"ML": {country: "Mali", chars: 28, bbanFormat: "2c,22n", code: "ML", ibanFields: "MLkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "ML13ML0160120102600100668497", bbanExample: "ML0160120102600100668497", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"MR": {country: "Mauritania", chars: 27, bbanFormat: "23n", code: "MR", ibanFields: "MRkk bbbb bsss sscc cccc cccc cxx", comment: "k = IBAN check digits (always 13) b = National bank code s = Branch code (fr:code guichet) c = Account number x = National check digits (fr:clé RIB)", fixedChecksum: "13", example: "MR1300020001010000123456753", bbanExample: "00020001010000123456753", bankPosition: [2]int{1, 5}, branchPosition: [2]int{6, 10}},
+	"MT": {country: "Malta", chars: 31, bbanFormat: "4a,5n,18c", code: "MT", ibanFields: "MTkk bbbb ssss sccc cccc cccc cccc ccc", comment: "b = BIC bank code s = Branch code c = Account number", example: "MT31MALT01100000000000000000123", bbanExample: "MALT01100000000000000000123", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 9}, sepa: true},
+	"MU": {country: "Mauritius", chars: 30, bbanFormat: "4a,19n,3a", code: "MU", ibanFields: "MUkk bbbb bbss cccc cccc cccc 000m mm", comment: "b = National bank code s = Branch identifier c = Account number 0 = Zeroes m = Currency Symbol", example: "MU43BOMM0101123456789101000MUR", bbanExample: "BOMM0101123456789101000MUR", bankPosition: [2]int{1, 6}, branchPosition: [2]int{7, 8}},
+	"MZ": {country: "Mozambique", chars: 25, bbanFormat: "21n", code: "MZ", ibanFields: "MZkk bbbb ssss cccc cccc cccx x", comment: "b = Bank code; s = Branch code; c = Account number; x = Check digit", example: "MZ59000301080016367102371", bbanExample: "000301080016367102371", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"NE": {country: "Niger", chars: 28, bbanFormat: "2c,22n", code: "NE", ibanFields: "NEkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "NE58NE0380100100130305000268", bbanExample: "NE0380100100130305000268", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"NI": {country: "Nicaragua", chars: 32, bbanFormat: "4a,24n", code: "NI", ibanFields: "NIkk bbbb ssss cccc cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "NI92BAMC000000000000000003123123", bbanExample: "BAMC000000000000000003123123", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"NL": {country: "Netherlands", chars: 18, bbanFormat: "4a,10n", code: "NL", ibanFields: "NLkk bbbb cccc cccc cc", comment: "b = BIC Bank code c = Account number", example: "NL02ABNA0123456789", bbanExample: "ABNA0123456789", bankPosition: [2]int{1, 4}, sepa: true},
+	"NO": {country: "Norway", chars: 15, bbanFormat: "11n", code: "NO", ibanFields: "NOkk bbbb cccc ccx", comment: "b = National bank code c = Account number x = Modulo-11 national check digit", example: "NO8330001234567", bbanExample: "30001234567", bankPosition: [2]int{1, 4}, sepa: true},
+	"PK": {country: "Pakistan", chars: 24, bbanFormat: "4c,16n", code: "PK", ibanFields: "PKkk bbbb cccc cccc cccc cccc", comment: "b = National bank code c = Account number", example: "PK36SCBL0000001123456702", bbanExample: "SCBL0000001123456702", bankPosition: [2]int{1, 4}},
+	"PL": {country: "Poland", chars: 28, bbanFormat: "24n", code: "PL", ibanFields: "PLkk bbbs sssx cccc cccc cccc cccc", comment: "b = National bank code s = Branch code x = National check digit c = Account number,", example: "PL10105000997603123456789123", bbanExample: "105000997603123456789123", bankPosition: [2]int{1, 3}, branchPosition: [2]int{4, 7}, sepa: true},
+	"PS": {country: "Palestinian territories", chars: 29, bbanFormat: "4c,21n", code: "PS", ibanFields: "PSkk bbbb cccc cccc cccc cccc cccc c", comment: "b = National bank code c = Account number", example: "PS92PALS000000000400123456702", bbanExample: "PALS000000000400123456702", bankPosition: [2]int{1, 4}},
+	"PT": {country: "Portugal", chars: 25, bbanFormat: "21n", code: "PT", ibanFields: "PTkk bbbb ssss cccc cccc cccx x", comment: "k = IBAN check digits (always = '50') b = National bank code s = Branch code C = Account number x = National check digit", fixedChecksum: "50", example: "PT50002700000001234567833", bbanExample: "002700000001234567833", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}, sepa: true},
+	"QA": {country: "Qatar", chars: 29, bbanFormat: "4a,21c", code: "QA", ibanFields: "QAkk bbbb cccc cccc cccc cccc cccc c", comment: "b = National bank code c = Account number", example: "QA54QNBA000000000000693123456", bbanExample: "QNBA000000000000693123456", bankPosition: [2]int{1, 4}},
+	"RE": {country: "Réunion", chars: 27, bbanFormat: "10n,11c,2n", code: "RE", ibanFields: "REkk bbbb bsss sscc cccc cccc cxx", comment: "b = Bank code; s = Branch code; c = Account number; x = National check digits", example: "RE4220041010050500013M02606", bbanExample: "20041010050500013M02606", bankPosition: [2]int{1, 5}, branchPosition: [2]int{6, 10}, sepa: true},
+	"RO": {country: "Romania", chars: 24, bbanFormat: "4a,16c", code: "RO", ibanFields: "ROkk bbbb cccc cccc cccc cccc", comment: "b = BIC Bank code c = Branch code and account number (bank-specific format)", example: "RO09BCYP0000001234567890", bbanExample: "BCYP0000001234567890", bankPosition: [2]int{1, 4}, sepa: true},
+	"RS": {country: "Serbia", chars: 22, bbanFormat: "18n", code: "RS", ibanFields: "RSkk bbbc cccc cccc cccc xx", comment: "b = National bank code c = Account number x = Account check digits", example: "RS35105008123123123173", bbanExample: "105008123123123173", bankPosition: [2]int{1, 3}, sepa: true},
+	"SA": {country: "Saudi Arabia", chars: 24, bbanFormat: "2n,18c", code: "SA", ibanFields: "SAkk bbcc cccc cccc cccc cccc", comment: "b = National bank code c = Account number preceded by zeros, if required", example: "SA4420000001234567891234", bbanExample: "20000001234567891234", bankPosition: [2]int{1, 2}},
+	"SC": {country: "Seychelles", chars: 31, bbanFormat: "4a,20n,3a", code: "SC", ibanFields: "SCkk bbbb cccc cccc cccc cccc cccc mmm", comment: "b = National bank code c = Account number m = Currency", example: "SC52BAHL01031234567890123456USD", bbanExample: "BAHL01031234567890123456USD", bankPosition: [2]int{1, 4}},
+	"SE": {country: "Sweden", chars: 24, bbanFormat: "20n", code: "SE", ibanFields: "SEkk bbbc cccc cccc cccc cccc", comment: "b = National bank code c = Account number", example: "SE1412345678901234567890", bbanExample: "12345678901234567890", bankPosition: [2]int{1, 3}, sepa: true},
+	"SI": {country: "Slovenia", chars: 19, bbanFormat: "15n", code: "SI", ibanFields: "SIkk bbss sccc cccc cxx", comment: "k = IBAN check digits (always = '56') b = National bank code s = Branch code c = Account number x = National check digits", fixedChecksum: "56", example: "SI56192001234567892", bbanExample: "192001234567892", bankPosition: [2]int{1, 2}, branchPosition: [2]int{3, 5}, sepa: true},
+	"SK": {country: "Slovakia", chars: 24, bbanFormat: "20n", code: "SK", ibanFields: "SKkk bbbb ssss sscc cccc cccc", comment: "b = National bank code s = Account number prefix c = Account number", example: "SK8975000000000012345671", bbanExample: "75000000000012345671", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 10}, sepa: true},
+	"SM": {country: "San Marino", chars: 27, bbanFormat: "1a,10n,12c", code: "SM", ibanFields: "SMkk xbbb bbss sssc cccc cccc ccc", comment: "x = Check char (it:CIN) b = National bank code (it:Associazione bancaria italiana or Codice ABI) s = Branch code (it:Coordinate bancarie or CAB – Codice d'Avviamento Bancario) c = Account number", example: "SM76P0854009812123456789123", bbanExample: "P0854009812123456789123", bankPosition: [2]int{2, 6}, branchPosition: [2]int{7, 11}, sepa: true},
+	"SN": {country: "Senegal", chars: 28, bbanFormat: "2c,22n", code: "SN", ibanFields: "SNkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "SN08SN0100152000048500003035", bbanExample: "SN0100152000048500003035", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"ST": {country: "Sao Tome and Principe", chars: 25, bbanFormat: "4c,17n", code: "ST", ibanFields: "STkk bbbb cccc cccc cccc cccc c", comment: "b = National bank code c = Account number", example: "ST23000200000289355710148", bbanExample: "000200000289355710148", bankPosition: [2]int{1, 4}},
+	"SV": {country: "El Salvador", chars: 28, bbanFormat: "4c,20n", code: "SV", ibanFields: "SVkk bbbb cccc cccc cccc cccc cccc", comment: "b = National bank code c = Account number", example: "SV43ACAT00000000000000123123", bbanExample: "ACAT00000000000000123123", bankPosition: [2]int{1, 4}},
+	"TD": {country: "Chad", chars: 27, bbanFormat: "23n", code: "TD", ibanFields: "TDkk bbbb ssss cccc cccc cccc ccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "TD8960002000010271091600153", bbanExample: "60002000010271091600153", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"TG": {country: "Togo", chars: 28, bbanFormat: "2c,22n", code: "TG", ibanFields: "TGkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "TG53TG0090604310346500400070", bbanExample: "TG0090604310346500400070", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},
+	"TL": {country: "East Timor", chars: 23, bbanFormat: "19n", code: "TL", ibanFields: "TLkk bbbc cccc cccc cccc cxx", comment: "k = IBAN check digits (always = '38') b = Bank identifier c = Account number x = National check digit", fixedChecksum: "38", example: "TL380080012345678910157", bbanExample: "0080012345678910157", bankPosition: [2]int{1, 3}},
+	"TN": {country: "Tunisia", chars: 24, bbanFormat: "20n", code: "TN", ibanFields: "TNkk bbss sccc cccc cccc cccc", comment: "k = IBAN check digits (always 59) b = National bank code s = Branch code c = Account number", fixedChecksum: "59", example: "TN5910006035183598478831", bbanExample: "10006035183598478831", bankPosition: [2]int{1, 2}, branchPosition: [2]int{3, 5}},
+	"TR": {country: "Turkey", chars: 26, bbanFormat: "5n,17c", code: "TR", ibanFields: "TRkk bbbb b0cc cccc cccc cccc cc", comment: "b = National bank code 0 = Reserved for future use (currently '0') c = Account number", example: "TR320010009999901234567890", bbanExample: "0010009999901234567890", bankPosition: [2]int{1, 5}},
+	"UA": {country: "Ukraine", chars: 29, bbanFormat: "4c,21n", code: "UA", ibanFields: "UAkk bbbb cccc cccc cccc cccc cccc c", comment: "b = National bank code c = Account number", example: "UA903052992990004149123456789", bbanExample: "3052992990004149123456789", bankPosition: [2]int{1, 4}},
+	"VA": {country: "Vatican", chars: 22, bbanFormat: "3n,15n", code: "VA", ibanFields: "VAkk bbb cccc cccc cccc ccc", comment: "b = National bank code c = Account number", example: "VA54001000000017267005", bbanExample: "001000000017267005", bankPosition: [2]int{1, 3}, sepa: true},
+	"VG": {country: "Virgin Islands, British", chars: 24, bbanFormat: "4c,16n", code: "VG", ibanFields: "VGkk bbbb cccc cccc cccc cccc", comment: "b = National bank code c = Account number", example: "VG21PACG0000000123456789", bbanExample: "PACG0000000123456789", bankPosition: [2]int{1, 4}},
+	"XK": {country: "Kosovo", chars: 20, bbanFormat: "4n,10n,2n", code: "XK", ibanFields: "XKkk bbbb cccc cccc cccc", comment: "b = National bank code c = Account number", example: "XK051212012345678906", bbanExample: "1212012345678906", bankPosition: [2]int{1, 4}},
+	"YT": {country: "Mayotte", chars: 27, bbanFormat: "10n,11c,2n", code: "YT", ibanFields: "YTkk bbbb bsss sscc cccc cccc cxx", comment: "b = Bank code; s = Branch code; c = Account number; x = National check digits", example: "YT3120041010050500013M02606", bbanExample: "20041010050500013M02606", bankPosition: [2]int{1, 5}, branchPosition: [2]int{6, 10}, sepa: true},
 }
@@ -0,0 +1,278 @@
+package iban
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Bank is an entry of a national bank directory.
+type Bank struct {
+	Country     string    // The country code
+	BankCode    string    // The bank code as it appears in IBANs of the country, see IBAN.BankCode
+	Name        string    // The name of the bank, e.g. "Deutsche Bank"
+	BIC         string    // The BIC of the bank, empty if unknown
+	Address     string    // The street address, empty if unknown
+	PostalCode  string    // The postal code, empty if unknown
+	City        string    // The city, e.g. "Frankfurt am Main"
+	ValidFrom   time.Time // The date from which the entry is valid, zero if unknown
+	ValidUntil  time.Time // The date until which the entry is valid, zero if it is not going to be removed
+	CheckMethod string    // The check method of the account numbers of German banks, e.g. "63", see CheckGermanAccount
+}
+
+// String returns the name and the city of the bank, e.g. "Deutsche Bank, Frankfurt am Main".
+func (b Bank) String() string {
+	return joinNonEmpty(", ", b.Name, b.City)
+}
+
+// IsValidAt reports whether the entry is valid at the given time. The entry is valid during the
+// whole day of ValidUntil.
+func (b Bank) IsValidAt(t time.Time) bool {
+	return !t.Before(b.ValidFrom) && (b.ValidUntil.IsZero() || t.Before(b.ValidUntil.AddDate(0, 0, 1)))
+}
+
+// BankDirectory finds the bank of an IBAN.
+type BankDirectory interface {
+	// Lookup returns the bank of the IBAN and whether it was found.
+	Lookup(i IBAN) (Bank, bool)
+}
+
+// Directory is an in-memory BankDirectory, filled from the national directory files with
+// ReadBundesbankFile, ReadDutchBICList, ReadAustrianBankFile, ReadSwissBankMaster or ReadBankCSV.
+// A Directory is safe for concurrent use once created.
+type Directory struct {
+	banks map[string]Bank
+}
+
+// NewDirectory creates a directory of the banks. When several banks have the same country
+// and bank code, the first one is kept.
+func NewDirectory(banks ...[]Bank) *Directory {
+	d := &Directory{banks: map[string]Bank{}}
+	for _, list := range banks {
+		for _, bank := range list {
+			key := directoryKey(bank.Country, bank.BankCode)
+			if _, exists := d.banks[key]; !exists {
+				d.banks[key] = bank
+			}
+		}
+	}
+	return d
+}
+
+// Lookup returns the bank of the IBAN by its country and bank code.
+func (d *Directory) Lookup(i IBAN) (Bank, bool) {
+	return d.LookupBankCode(i.CountryCode, i.BankCode)
+}
+
+// LookupBankCode returns the bank with the country and bank code, e.g. "DE" and "37040044".
+func (d *Directory) LookupBankCode(countryCode, bankCode string) (Bank, bool) {
+	bank, exists := d.banks[directoryKey(countryCode, bankCode)]
+	return bank, exists
+}
+
+// Len returns the number of banks in the directory.
+func (d *Directory) Len() int {
+	return len(d.banks)
+}
+
+// directoryKey is the key of a bank in a Directory.
+func directoryKey(countryCode, bankCode string) string {
+	return strings.ToUpper(countryCode) + ":" + strings.ToUpper(bankCode)
+}
+
+// ReadBankCSV reads a generic CSV bank directory with a header row. The columns are
+// country, bank_code, name, bic, address, postal_code, city, valid_from, valid_until and
+// check_method; country, bank_code and name are required. The dates are formatted as 2006-01-02.
+// The separator (comma or semicolon) is detected from the header row.
+func ReadBankCSV(r io.Reader) ([]Bank, error) {
+	table, err := readDirectoryTable(r, []string{"country"}, []string{"bank_code"}, []string{"name"}, []string{"bic"},
+		[]string{"address"}, []string{"postal_code"}, []string{"city"}, []string{"valid_from"}, []string{"valid_until"},
+		[]string{"check_method"})
+	if err != nil {
+		return nil, err
+	}
+	if err := table.require("bank_code", "name"); err != nil {
+		return nil, err
+	}
+
+	var banks []Bank
+	for table.next() {
+		bank := Bank{
+			Country:     strings.ToUpper(table.value("country")),
+			BankCode:    table.value("bank_code"),
+			Name:        table.value("name"),
+			BIC:         strings.ToUpper(table.value("bic")),
+			Address:     table.value("address"),
+			PostalCode:  table.value("postal_code"),
+			City:        table.value("city"),
+			CheckMethod: strings.ToUpper(table.value("check_method")),
+		}
+		if bank.ValidFrom, err = parseDirectoryDate(table.value("valid_from")); err != nil {
+			return nil, table.errorf("%v", err)
+		}
+		if bank.ValidUntil, err = parseDirectoryDate(table.value("valid_until")); err != nil {
+			return nil, table.errorf("%v", err)
+		}
+		banks = append(banks, bank)
+	}
+	return banks, table.err
+}
+
+// directoryTable reads the rows of a CSV directory file by column name.
+type directoryTable struct {
+	reader  *csv.Reader
+	columns map[string]int // The index of every known column by its first name
+	record  []string
+	line    int
+	err     error
+}
+
+// readDirectoryTable reads a CSV directory file that may be encoded in ISO 8859-1 and may have
+// lines before its header row. Every column is given as a list of alternative names; the first
+// name is the one passed to value. The header row is the first row that holds the first column.
+func readDirectoryTable(r io.Reader, columns ...[]string) (*directoryTable, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(decodeLatin1(data), []byte("\uFEFF"))
+
+	// Find the header row and detect the separator from it
+	var header string
+	line := 0
+	for len(data) > 0 {
+		line++
+		var next []byte
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			header, next = string(data[:i]), data[i+1:]
+		} else {
+			header, next = string(data), nil
+		}
+		if findColumn(splitHeader(header), columns[0]) >= 0 {
+			break
+		}
+		data, header = next, ""
+	}
+	if header == "" {
+		return nil, fmt.Errorf("IBAN: the directory file has no column <%s>", columns[0][0])
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = detectSeparator(header)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	names, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	table := &directoryTable{reader: reader, columns: map[string]int{}, line: line}
+	for _, aliases := range columns {
+		if index := findColumn(names, aliases); index >= 0 {
+			table.columns[aliases[0]] = index
+		}
+	}
+	return table, nil
+}
+
+// require returns an error if the header row lacks one of the columns.
+func (t *directoryTable) require(columns ...string) error {
+	for _, column := range columns {
+		if _, exists := t.columns[column]; !exists {
+			return fmt.Errorf("IBAN: the directory file has no column <%s>", column)
+		}
+	}
+	return nil
+}
+
+// next reads the next row that is not empty; it returns false at the end or on an error.
+func (t *directoryTable) next() bool {
+	for {
+		record, err := t.reader.Read()
+		if err == io.EOF {
+			return false
+		}
+		if err != nil {
+			t.err = err
+			return false
+		}
+		t.line++
+		if len(record) > 1 || strings.TrimSpace(record[0]) != "" {
+			t.record = record
+			return true
+		}
+	}
+}
+
+// value returns the trimmed value of the column in the current row, empty if there is none.
+func (t *directoryTable) value(column string) string {
+	index, exists := t.columns[column]
+	if !exists || index >= len(t.record) {
+		return ""
+	}
+	return strings.TrimSpace(t.record[index])
+}
+
+// errorf returns an error for the current row.
+func (t *directoryTable) errorf(format string, args ...any) error {
+	return fmt.Errorf("IBAN: directory line %d: %s", t.line, fmt.Sprintf(format, args...))
+}
+
+// splitHeader splits a header row at its detected separator.
+func splitHeader(header string) []string {
+	return strings.Split(header, string(detectSeparator(header)))
+}
+
+// detectSeparator returns the separator of a CSV row: semicolon, tab or comma.
+func detectSeparator(header string) rune {
+	separator, count := ',', strings.Count(header, ",")
+	for _, candidate := range []rune{';', '\t'} {
+		if n := strings.Count(header, string(candidate)); n > count {
+			separator, count = candidate, n
+		}
+	}
+	return separator
+}
+
+// findColumn returns the index of the first column with one of the names, ignoring case,
+// spaces and quotes, or -1.
+func findColumn(names []string, aliases []string) int {
+	for i, name := range names {
+		name = strings.Trim(strings.TrimSpace(name), `"`)
+		for _, alias := range aliases {
+			if strings.EqualFold(name, alias) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// decodeLatin1 converts ISO 8859-1 text to UTF-8; valid UTF-8 is returned as it is.
+func decodeLatin1(data []byte) []byte {
+	if utf8.Valid(data) {
+		return data
+	}
+	decoded := make([]byte, 0, len(data)+len(data)/8)
+	for _, b := range data {
+		decoded = utf8.AppendRune(decoded, rune(b))
+	}
+	return decoded
+}
+
+// parseDirectoryDate parses a date as 2006-01-02, 20060102 or 02.01.2006; empty is the zero time.
+func parseDirectoryDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range []string{"2006-01-02", "20060102", "02.01.2006"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date <%s>", value)
+}
@@ -0,0 +1,166 @@
+package iban
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// bundesbankLine builds a line of the Bundesbank BLZ file in ISO 8859-1.
+func bundesbankLine(blz, feature, name, postalCode, city, bic, method, change string) string {
+	line := fmt.Sprintf("%-8s%-1s%-58s%-5s%-35s%-27s%-5s%-11s%-2s%-6s%-1s%-1s%-8s",
+		blz, feature, name, postalCode, city, name, "", bic, method, "000001", change, "0", "00000000")
+	latin1 := make([]byte, 0, len(line))
+	for _, r := range line {
+		latin1 = append(latin1, byte(r))
+	}
+	return string(latin1)
+}
+
+func TestReadBundesbankFile(t *testing.T) {
+	validFrom := time.Date(2026, 9, 8, 0, 0, 0, 0, time.UTC)
+	validUntil := time.Date(2026, 12, 6, 0, 0, 0, 0, time.UTC)
+	file := strings.Join([]string{
+		bundesbankLine("37040044", "1", "Commerzbank", "50447", "Köln", "COBADEFFXXX", "13", "U"),
+		bundesbankLine("37040044", "2", "Commerzbank", "51373", "Leverkusen", "", "13", "U"),
+		bundesbankLine("10020000", "1", "Berliner Bank", "10890", "Berlin", "BEBEDEBBXXX", "09", "D"),
+	}, "\r\n") + "\r\n"
+
+	banks, err := ReadBundesbankFile(strings.NewReader(file), validFrom, validUntil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Bank{
+		{Country: "DE", BankCode: "37040044", Name: "Commerzbank", BIC: "COBADEFFXXX", PostalCode: "50447", City: "Köln", ValidFrom: validFrom,
+			CheckMethod: "13"},
+		{Country: "DE", BankCode: "10020000", Name: "Berliner Bank", BIC: "BEBEDEBBXXX", PostalCode: "10890", City: "Berlin", ValidFrom: validFrom, ValidUntil: validUntil,
+			CheckMethod: "09"},
+	}
+	if len(banks) != len(expected) {
+		t.Fatalf("got %+v", banks)
+	}
+	for i := range expected {
+		if banks[i] != expected[i] {
+			t.Errorf("got %+v, expected %+v", banks[i], expected[i])
+		}
+	}
+
+	if _, err := ReadBundesbankFile(strings.NewReader("37040044 1 Commerzbank\n"), validFrom, validUntil); err == nil {
+		t.Error("a short line was accepted")
+	}
+}
+
+func TestReadDutchBICList(t *testing.T) {
+	file := "Identifier;BIC;Naam betaaldienstverlener\r\nABNA;ABNANL2A;ABN AMRO BANK N.V.\r\nINGB;INGBNL2A;ING Bank N.V.\r\n"
+	banks, err := ReadDutchBICList(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(banks) != 2 || banks[1] != (Bank{Country: "NL", BankCode: "INGB", Name: "ING Bank N.V.", BIC: "INGBNL2A"}) {
+		t.Errorf("got %+v", banks)
+	}
+}
+
+func TestReadAustrianBankFile(t *testing.T) {
+	// A Latin-1 file with a preamble, like the export of the Oesterreichische Nationalbank
+	file := "Stichtag: 01.10.2026\n\nBankleitzahl;Bankenname;Stra\xdfe;PLZ;Ort;SWIFT-Code\n" +
+		"12000;UniCredit Bank Austria AG;Rothschildplatz 1;1020;Wien;BKAUATWWXXX\n"
+	banks, err := ReadAustrianBankFile(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Bank{Country: "AT", BankCode: "12000", Name: "UniCredit Bank Austria AG", BIC: "BKAUATWWXXX",
+		Address: "Rothschildplatz 1", PostalCode: "1020", City: "Wien"}
+	if len(banks) != 1 || banks[0] != expected {
+		t.Errorf("got %+v", banks)
+	}
+}
+
+func TestReadSwissBankMaster(t *testing.T) {
+	file := "IID,Bank or institution name,Street name,Building number,Post code,Town name,BIC,Valid from\n" +
+		"100,Schweizerische Nationalbank,Börsenstrasse,15,8001,Zürich,SNBZCHZZXXX,20230101\n"
+	banks, err := ReadSwissBankMaster(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Bank{Country: "CH", BankCode: "00100", Name: "Schweizerische Nationalbank", BIC: "SNBZCHZZXXX",
+		Address: "Börsenstrasse 15", PostalCode: "8001", City: "Zürich", ValidFrom: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	if len(banks) != 1 || banks[0] != expected {
+		t.Errorf("got %+v", banks)
+	}
+}
+
+func TestReadBankCSV(t *testing.T) {
+	file := "country,bank_code,name,bic,city,valid_until\nbe,539,ING Belgium,bbrubebb,Brussels,2027-01-31\nFR,30006,Crédit Agricole,,Paris,\n"
+	banks, err := ReadBankCSV(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Bank{Country: "BE", BankCode: "539", Name: "ING Belgium", BIC: "BBRUBEBB", City: "Brussels",
+		ValidUntil: time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC)}
+	if len(banks) != 2 || banks[0] != expected || banks[1].BIC != "" {
+		t.Errorf("got %+v", banks)
+	}
+
+	for _, invalid := range []string{
+		"bank_code,name\n539,ING Belgium\n",
+		"country,name\nBE,ING Belgium\n",
+		"country,bank_code,name,valid_from\nBE,539,ING Belgium,31/01/2027\n",
+	} {
+		if _, err := ReadBankCSV(strings.NewReader(invalid)); err == nil {
+			t.Errorf("%q was accepted", invalid)
+		}
+	}
+}
+
+func TestDirectoryLookup(t *testing.T) {
+	commerzbank := Bank{Country: "DE", BankCode: "37040044", Name: "Commerzbank", City: "Köln", BIC: "COBADEFFXXX"}
+	directory := NewDirectory(
+		[]Bank{commerzbank},
+		[]Bank{{Country: "DE", BankCode: "37040044", Name: "Duplicate"}, {Country: "GB", BankCode: "WEST", Name: "Westminster"}},
+	)
+	if directory.Len() != 2 {
+		t.Errorf("got %d banks", directory.Len())
+	}
+
+	iban, err := NewIBAN("DE89 3704 0044 0532 0130 00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bank, found := directory.Lookup(iban)
+	if !found || bank != commerzbank {
+		t.Errorf("got %+v, %v", bank, found)
+	}
+	if bank.String() != "Commerzbank, Köln" {
+		t.Errorf("got %q", bank.String())
+	}
+	if _, found := directory.LookupBankCode("de", "10020000"); found {
+		t.Error("an unknown bank code was found")
+	}
+	if _, found := directory.LookupBankCode("gb", "west"); !found {
+		t.Error("the bank code was not found ignoring case")
+	}
+}
+
+func TestBankIsValidAt(t *testing.T) {
+	bank := Bank{ValidFrom: time.Date(2026, 9, 8, 0, 0, 0, 0, time.UTC), ValidUntil: time.Date(2026, 12, 6, 0, 0, 0, 0, time.UTC)}
+	for _, test := range []struct {
+		date  time.Time
+		valid bool
+	}{
+		{time.Date(2026, 9, 7, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2026, 9, 8, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, 12, 6, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, 12, 6, 10, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, 12, 6, 23, 59, 59, 0, time.UTC), true},
+		{time.Date(2026, 12, 7, 0, 0, 0, 0, time.UTC), false},
+	} {
+		if bank.IsValidAt(test.date) != test.valid {
+			t.Errorf("%v: expected %v", test.date, test.valid)
+		}
+	}
+	if !(Bank{}).IsValidAt(time.Now()) {
+		t.Error("a bank without dates is not valid")
+	}
+}
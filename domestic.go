@@ -0,0 +1,144 @@
+package iban
+
+import "strings"
+
+// DomesticAccount is the national (domestic) representation of an IBAN,
+// as local banks and legacy payment files expect it.
+type DomesticAccount struct {
+	Country       string // The country code
+	BankCode      string // The bank code, e.g. the German BLZ
+	Branch        string // The branch code, e.g. the British sort code
+	Account       string // The account number, without padding where the country does not use it
+	NationalCheck string // The national check digits, e.g. the French clé RIB
+	Formatted     string // The account as local users write it, e.g. "12-34-56 12345678"
+}
+
+// String returns the formatted domestic account.
+func (d DomesticAccount) String() string {
+	return d.Formatted
+}
+
+// domesticFormats formats the domestic account of a country. The parts are already
+// filled in from the IBAN fields when the function is called.
+var domesticFormats = map[string]func(d *DomesticAccount){
+	"AT": formatBankAndAccount("BLZ ", ", Konto "),
+	"BE": formatBelgian,
+	"CH": formatBankAndAccount("", " "),
+	"DE": formatGerman,
+	"ES": formatSpanish,
+	"FR": formatRIB,
+	"GB": formatSortCode,
+	"IT": formatItalian,
+	"LI": formatBankAndAccount("", " "),
+	"NL": formatBankAndAccount("", " "),
+	"NO": formatNorwegian,
+	"PT": formatPortuguese,
+
+	// Jersey, Guernsey and the Isle of Man use British sort codes,
+	// Monaco and the French territories the French RIB and San Marino the Italian format.
+	"GG": formatSortCode,
+	"IM": formatSortCode,
+	"JE": formatSortCode,
+	"BL": formatRIB,
+	"MC": formatRIB,
+	"MF": formatRIB,
+	"RE": formatRIB,
+	"YT": formatRIB,
+	"SM": formatItalian,
+}
+
+// Domestic returns the national representation of the IBAN, e.g. the German BLZ and
+// Kontonummer, the British sort code and account number or the French RIB.
+// Countries without a specific rule get their bank code, branch code, account number and
+// national check digits separated by spaces.
+// The IBAN number is validated again, so an IBAN that was not created by NewIBAN is rejected if invalid.
+func (i IBAN) Domestic() (DomesticAccount, error) {
+	parsed, err := NewIBAN(i.Number)
+	if err != nil {
+		return DomesticAccount{}, err
+	}
+
+	domestic := DomesticAccount{
+		Country:       parsed.CountryCode,
+		BankCode:      parsed.BankCode,
+		Branch:        parsed.Branch,
+		Account:       parsed.Account,
+		NationalCheck: parsed.NationalCheck,
+	}
+	if format, exists := domesticFormats[parsed.CountryCode]; exists {
+		format(&domestic)
+	} else {
+		domestic.Formatted = joinNonEmpty(" ", domestic.BankCode, domestic.Branch, domestic.Account, domestic.NationalCheck)
+	}
+	return domestic, nil
+}
+
+// formatGerman formats "BLZ 370 400 44, Konto 532013000"; the Kontonummer has no leading zeros.
+func formatGerman(d *DomesticAccount) {
+	d.Account = trimLeadingZeros(d.Account)
+	d.Formatted = "BLZ " + d.BankCode[:3] + " " + d.BankCode[3:6] + " " + d.BankCode[6:] + ", Konto " + d.Account
+}
+
+// formatBankAndAccount formats the bank code and the account number without leading zeros.
+func formatBankAndAccount(bankPrefix, accountPrefix string) func(d *DomesticAccount) {
+	return func(d *DomesticAccount) {
+		d.Account = trimLeadingZeros(d.Account)
+		d.Formatted = bankPrefix + d.BankCode + accountPrefix + d.Account
+	}
+}
+
+// formatSortCode formats "12-34-56 12345678".
+func formatSortCode(d *DomesticAccount) {
+	d.Formatted = d.Branch[:2] + "-" + d.Branch[2:4] + "-" + d.Branch[4:] + " " + d.Account
+}
+
+// formatRIB formats the French RIB "20041 01005 0500013M026 06" (banque, guichet, compte and clé).
+func formatRIB(d *DomesticAccount) {
+	d.Formatted = joinNonEmpty(" ", d.BankCode, d.Branch, d.Account, d.NationalCheck)
+}
+
+// formatBelgian formats "539-0075470-34"; the bank code is part of the account number.
+func formatBelgian(d *DomesticAccount) {
+	d.Formatted = d.BankCode + "-" + d.Account + "-" + d.NationalCheck
+}
+
+// formatSpanish formats the CCC "2100 0418 45 0200051332" (entidad, oficina, DC and cuenta).
+func formatSpanish(d *DomesticAccount) {
+	d.Formatted = joinNonEmpty(" ", d.BankCode, d.Branch, d.NationalCheck, d.Account)
+}
+
+// formatItalian formats "X 05428 11101 000000123456" (CIN, ABI, CAB and conto).
+func formatItalian(d *DomesticAccount) {
+	d.Formatted = joinNonEmpty(" ", d.NationalCheck, d.BankCode, d.Branch, d.Account)
+}
+
+// formatPortuguese formats the NIB "0002 0000 00012345678 13".
+func formatPortuguese(d *DomesticAccount) {
+	d.Formatted = joinNonEmpty(" ", d.BankCode, d.Branch, d.Account, d.NationalCheck)
+}
+
+// formatNorwegian formats "8601.11.17947".
+func formatNorwegian(d *DomesticAccount) {
+	number := d.BankCode + d.Account + d.NationalCheck
+	d.Formatted = number[:4] + "." + number[4:6] + "." + number[6:]
+}
+
+// trimLeadingZeros removes the leading zeros of a number, keeping at least one digit.
+func trimLeadingZeros(number string) string {
+	trimmed := strings.TrimLeft(number, "0")
+	if trimmed == "" && number != "" {
+		return "0"
+	}
+	return trimmed
+}
+
+// joinNonEmpty joins the non-empty parts with the separator.
+func joinNonEmpty(separator string, parts ...string) string {
+	var nonEmpty []string
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, separator)
+}
@@ -0,0 +1,48 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+var domesticTestNumbers = []struct {
+	number    string
+	account   string
+	formatted string
+}{
+	{"DE89 3704 0044 0532 0130 00", "532013000", "BLZ 370 400 44, Konto 532013000"},
+	{"GB82 WEST 1234 5698 7654 32", "98765432", "12-34-56 98765432"},
+	{"FR14 2004 1010 0505 0001 3M02 606", "0500013M026", "20041 01005 0500013M026 06"},
+	{"BE68 5390 0754 7034", "0075470", "539-0075470-34"},
+	{"ES91 2100 0418 4502 0005 1332", "0200051332", "2100 0418 45 0200051332"},
+	{"IT60 X054 2811 1010 0000 0123 456", "000000123456", "X 05428 11101 000000123456"},
+	{"NL02 ABNA 0123 4567 89", "123456789", "ABNA 123456789"},
+	{"NO93 8601 1117 947", "111794", "8601.11.17947"},
+	{"AT48 3200 0000 1234 5864", "12345864", "BLZ 32000, Konto 12345864"},
+	{"PT50 0002 0000 0001 2345 6781 3", "00012345678", "0002 0000 00012345678 13"},
+	{"DK95 2000 0123 4567 89", "0123456789", "2000 0123456789"},
+}
+
+func TestDomestic(t *testing.T) {
+	for _, test := range domesticTestNumbers {
+		result, err := NewIBAN(test.number)
+		if err != nil {
+			t.Errorf("%s: %v", test.number, err)
+			continue
+		}
+		domestic, err := result.Domestic()
+		if err != nil {
+			t.Errorf("%s: %v", test.number, err)
+			continue
+		}
+		if domestic.Account != test.account || domestic.String() != test.formatted {
+			t.Errorf("%s: got %+v, expected account %q formatted %q", test.number, domestic, test.account, test.formatted)
+		}
+	}
+}
+
+func TestDomesticInvalidIBAN(t *testing.T) {
+	if _, err := (IBAN{CountryCode: "DE"}).Domestic(); !errors.Is(err, ErrInvalidIBAN) {
+		t.Errorf("expected ErrInvalidIBAN, got %v", err)
+	}
+}
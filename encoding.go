@@ -0,0 +1,54 @@
+package iban
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Electronic returns the IBAN in its electronic format: upper case and without spaces.
+func (i IBAN) Electronic() string {
+	return strings.ReplaceAll(i.Number, " ", "")
+}
+
+// String returns the IBAN in its print format, in groups of four characters.
+func (i IBAN) String() string {
+	return i.Number
+}
+
+// MarshalText implements encoding.TextMarshaler. The IBAN is encoded in its electronic format.
+func (i IBAN) MarshalText() ([]byte, error) {
+	return []byte(i.Electronic()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The IBAN is validated with NewIBAN,
+// so any format NewIBAN accepts is decoded.
+func (i *IBAN) UnmarshalText(text []byte) error {
+	parsed, err := NewIBAN(string(text))
+	if err != nil {
+		return err
+	}
+	*i = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The IBAN is encoded as a string in its electronic format,
+// the zero IBAN as null.
+func (i IBAN) MarshalJSON() ([]byte, error) {
+	if i == (IBAN{}) {
+		return []byte("null"), nil
+	}
+	return json.Marshal(i.Electronic())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The IBAN must be a JSON string and is validated
+// with NewIBAN. null leaves the IBAN unchanged.
+func (i *IBAN) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return i.UnmarshalText([]byte(text))
+}
@@ -0,0 +1,66 @@
+package iban
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+)
+
+type paymentRequest struct {
+	Creditor IBAN  `json:"creditor"`
+	Debtor   *IBAN `json:"debtor,omitempty"`
+}
+
+func TestJSON(t *testing.T) {
+	var request paymentRequest
+	if err := json.Unmarshal([]byte(`{"creditor": "gb82 west 1234 5698 7654 32"}`), &request); err != nil {
+		t.Fatal(err)
+	}
+	if request.Creditor.Number != "GB82 WEST 1234 5698 7654 32" || request.Creditor.BankCode != "WEST" || request.Debtor != nil {
+		t.Errorf("got %+v", request)
+	}
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"creditor":"GB82WEST12345698765432"}` {
+		t.Errorf("got %s", data)
+	}
+
+	data, err = json.Marshal(paymentRequest{})
+	if err != nil || string(data) != `{"creditor":null}` {
+		t.Errorf("got %s (%v)", data, err)
+	}
+	if err := json.Unmarshal(data, &request); err != nil {
+		t.Errorf("null: %v", err)
+	}
+}
+
+func TestJSONInvalid(t *testing.T) {
+	var request paymentRequest
+	err := json.Unmarshal([]byte(`{"creditor": "GB83 WEST 1234 5698 7654 32"}`), &request)
+	if !errors.Is(err, &ValidationError{Reason: BadChecksum}) {
+		t.Errorf("expected BadChecksum, got %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"creditor": 42}`), &request); err == nil {
+		t.Error("expected an error for a number")
+	}
+}
+
+func TestText(t *testing.T) {
+	var value struct {
+		IBAN IBAN `xml:"iban"`
+	}
+	if err := xml.Unmarshal([]byte(`<payment><iban>DE89 3704 0044 0532 0130 00</iban></payment>`), &value); err != nil {
+		t.Fatal(err)
+	}
+	text, err := value.IBAN.MarshalText()
+	if err != nil || string(text) != "DE89370400440532013000" {
+		t.Errorf("got %s (%v)", text, err)
+	}
+	if err := value.IBAN.UnmarshalText([]byte("DE00")); !errors.Is(err, ErrInvalidIBAN) {
+		t.Errorf("expected ErrInvalidIBAN, got %v", err)
+	}
+}
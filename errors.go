@@ -0,0 +1,118 @@
+package iban
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrInvalidIBAN is returned when an invalid IBAN number was received
+var ErrInvalidIBAN = errors.New("invalid IBAN number received")
+
+// ErrInvalidComponent is returned when an IBAN cannot be built from the given account details
+var ErrInvalidComponent = errors.New("invalid account component received")
+
+// Reason tells why an IBAN number was rejected.
+type Reason int
+
+// The reasons for which an IBAN number can be rejected.
+const (
+	TooShort              Reason = iota + 1 // The IBAN is shorter than any valid IBAN
+	UnknownCountry                          // The country code is not in the country list
+	WrongLength                             // The length does not match the length of the country
+	BadChecksum                             // The IBAN check digits are wrong
+	BadBBANFormat                           // The BBAN does not match the format of the country
+	BadNationalCheckDigit                   // The national check digits of the BBAN are wrong
+	IllegalCharacter                        // The IBAN contains a character other than A-Z and 0-9
+	BadFixedChecksum                        // The IBAN check digits differ from the constant used by the country
+	CountryNotAllowed                       // The country is blocked or not allowed by the Validator
+	NotSEPA                                 // The country is not part of SEPA and the Validator only accepts SEPA countries
+	NationalCheckRequired                   // The Validator requires national check digits the country does not have
+	NotElectronicFormat                     // The IBAN is not in the electronic format (see Normalize) and the Validator is strict
+	RuleViolation                           // A custom rule of the Validator rejected the IBAN
+	BadAccountCheckDigit                    // The German account number fails the check method of its bank and the Validator checks it
+)
+
+var reasonNames = map[Reason]string{
+	TooShort:              "TooShort",
+	UnknownCountry:        "UnknownCountry",
+	WrongLength:           "WrongLength",
+	BadChecksum:           "BadChecksum",
+	BadBBANFormat:         "BadBBANFormat",
+	BadNationalCheckDigit: "BadNationalCheckDigit",
+	IllegalCharacter:      "IllegalCharacter",
+	BadFixedChecksum:      "BadFixedChecksum",
+	CountryNotAllowed:     "CountryNotAllowed",
+	NotSEPA:               "NotSEPA",
+	NationalCheckRequired: "NationalCheckRequired",
+	NotElectronicFormat:   "NotElectronicFormat",
+	RuleViolation:         "RuleViolation",
+	BadAccountCheckDigit:  "BadAccountCheckDigit",
+}
+
+// String returns the name of the reason, e.g. "BadChecksum".
+func (r Reason) String() string {
+	if name, exists := reasonNames[r]; exists {
+		return name
+	}
+	return fmt.Sprintf("Reason(%d)", int(r))
+}
+
+// Reasons returns all reason codes in ascending order.
+func Reasons() []Reason {
+	reasons := make([]Reason, 0, len(reasonNames))
+	for reason := range reasonNames {
+		reasons = append(reasons, reason)
+	}
+	sort.Slice(reasons, func(i, j int) bool { return reasons[i] < reasons[j] })
+	return reasons
+}
+
+// ValidationError describes why an IBAN number was rejected.
+// It wraps ErrInvalidIBAN, so errors.Is(err, ErrInvalidIBAN) holds for every ValidationError.
+type ValidationError struct {
+	Reason   Reason // Why the IBAN was rejected
+	Country  string // The country code of the IBAN, if known
+	Expected string // The expected value (e.g. the length or the check digits)
+	Actual   string // The value found in the IBAN
+	Position int    // The index (starting at 0) of the offending character in the electronic IBAN, -1 if none
+	Err      error  // The underlying error with more details, if any (e.g. a *FormatError)
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	switch e.Reason {
+	case TooShort:
+		return fmt.Sprintf("IBAN: incorrect IBAN string passed <%s>", e.Actual)
+	case UnknownCountry:
+		return fmt.Sprintf("IBAN: country <%s> is not in the list", e.Country)
+	case WrongLength:
+		return fmt.Sprintf("IBAN: length (%s) does not match configuration length (%s)", e.Actual, e.Expected)
+	case IllegalCharacter:
+		return fmt.Sprintf("IBAN: illegal character %q at position %d", e.Actual, e.Position)
+	case NotElectronicFormat:
+		return fmt.Sprintf("IBAN: character %q at position %d is not allowed in the electronic format", e.Actual, e.Position)
+	}
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	if e.Expected != "" {
+		return fmt.Sprintf("IBAN: %s for country <%s>: expected <%s>, got <%s>", e.Reason, e.Country, e.Expected, e.Actual)
+	}
+	return fmt.Sprintf("IBAN: %s for country <%s>", e.Reason, e.Country)
+}
+
+// Unwrap returns ErrInvalidIBAN and the underlying error, if any.
+func (e *ValidationError) Unwrap() []error {
+	if e.Err != nil {
+		return []error{ErrInvalidIBAN, e.Err}
+	}
+	return []error{ErrInvalidIBAN}
+}
+
+// Is reports whether the target is a *ValidationError with the same reason,
+// so errors.Is(err, &ValidationError{Reason: BadChecksum}) can be used to test for a reason.
+func (e *ValidationError) Is(target error) bool {
+	t, ok := target.(*ValidationError)
+	return ok && t.Reason == e.Reason
+}
@@ -0,0 +1,95 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+var validationErrorTestNumbers = []struct {
+	number   string
+	reason   Reason
+	expected string
+	actual   string
+	position int
+}{
+	{"GB82 WEST", TooShort, "15", "GB**WEST", -1},
+	{"GB82/WEST/1234/5698/7654/32", IllegalCharacter, "", "/", 4},
+	{"XX82 WEST 1234 5698 7654 32", UnknownCountry, "", "XX", 0},
+	{"GB82 WEST 1234 5698 7654 321", WrongLength, "22", "23", -1},
+	{"GB82 WEST 1234 5698 7654 3A", BadBBANFormat, "14n", "A", 21},
+	{"GB83 WEST 1234 5698 7654 32", BadChecksum, "82", "83", 2},
+	{"GBA2 WEST 1234 5698 7654 32", BadChecksum, "82", "A2", 2},
+}
+
+func TestValidationError(t *testing.T) {
+	for _, test := range validationErrorTestNumbers {
+		ok, formatted, err := IsCorrectIban(test.number, false)
+		if ok || formatted != "" {
+			t.Errorf("%s: expected the IBAN to be rejected", test.number)
+		}
+
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Errorf("%s: expected a ValidationError, got %v", test.number, err)
+			continue
+		}
+		if validationErr.Reason != test.reason || validationErr.Expected != test.expected ||
+			validationErr.Actual != test.actual || validationErr.Position != test.position {
+			t.Errorf("%s: got %+v", test.number, *validationErr)
+		}
+		if !errors.Is(err, ErrInvalidIBAN) {
+			t.Errorf("%s: error does not wrap ErrInvalidIBAN", test.number)
+		}
+		if !errors.Is(err, &ValidationError{Reason: test.reason}) {
+			t.Errorf("%s: errors.Is does not match reason %s", test.number, test.reason)
+		}
+
+		if _, err := NewIBAN(test.number); !errors.Is(err, &ValidationError{Reason: test.reason}) {
+			t.Errorf("%s: NewIBAN returned %v", test.number, err)
+		}
+	}
+}
+
+func TestReasonString(t *testing.T) {
+	if BadNationalCheckDigit.String() != "BadNationalCheckDigit" {
+		t.Errorf("got %s", BadNationalCheckDigit)
+	}
+	if Reason(0).String() != "Reason(0)" {
+		t.Errorf("got %s", Reason(0))
+	}
+}
+
+func TestReasons(t *testing.T) {
+	reasons := Reasons()
+	for i, reason := range reasons {
+		if reason != Reason(i+1) {
+			t.Errorf("reason %d: got %s, expected the reasons from TooShort without gaps", i, reason)
+		}
+	}
+	if len(reasons) < int(RuleViolation) {
+		t.Errorf("got %v", reasons)
+	}
+}
+
+var fixedChecksumTestNumbers = []struct {
+	number   string
+	expected string
+}{
+	{"PT92 0002 0000 0001 2345 6783 3", "50"},
+	{"SI29 1920 0123 4567 893", "56"},
+	{"BA76 5680 0001 2345 6780", "39"},
+}
+
+func TestFixedChecksum(t *testing.T) {
+	for _, test := range fixedChecksumTestNumbers {
+		_, _, err := IsCorrectIban(test.number, false)
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Reason != BadFixedChecksum {
+			t.Errorf("%s: expected BadFixedChecksum, got %v", test.number, err)
+			continue
+		}
+		if validationErr.Expected != test.expected {
+			t.Errorf("%s: expected fixed check digits %s, got %s", test.number, test.expected, validationErr.Expected)
+		}
+	}
+}
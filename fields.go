@@ -0,0 +1,73 @@
+package iban
+
+import "strings"
+
+// The letters used in the ibanFields of the country list. Besides these, "m" is used for
+// the currency, "n" for the owner account number and "i" for the holder's identification number.
+// Digits and upper case letters in a pattern are literal characters and are not extracted.
+const (
+	fieldChecksum      = 'k' // IBAN check digits
+	fieldBank          = 'b' // Bank code
+	fieldBranch        = 's' // Branch code
+	fieldAccount       = 'c' // Account number
+	fieldNationalCheck = 'x' // National check digits
+	fieldAccountType   = 't' // Account type
+)
+
+// fieldMap gives for every field letter the positions of its characters in the electronic IBAN.
+// A field's characters are usually contiguous, but need not be (e.g. the Hungarian check digits).
+type fieldMap map[byte][]int
+
+// parseIBANFields compiles an ibanFields pattern such as "ADkk bbbb ssss cccc cccc cccc".
+// The grouping spaces are skipped, so the positions match the IBAN without spaces.
+func parseIBANFields(pattern string) fieldMap {
+	fields := fieldMap{}
+	position := 0
+	for i := 0; i < len(pattern); i++ {
+		letter := pattern[i]
+		if letter == ' ' {
+			continue
+		}
+		if letter >= 'a' && letter <= 'z' {
+			fields[letter] = append(fields[letter], position)
+		}
+		position++
+	}
+	return fields
+}
+
+// extract returns the characters of the field in the electronic IBAN.
+func (m fieldMap) extract(iban string, letter byte) string {
+	positions := m[letter]
+	if len(positions) == 0 {
+		return ""
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(positions))
+	for _, position := range positions {
+		if position < len(iban) {
+			builder.WriteByte(iban[position])
+		}
+	}
+	return builder.String()
+}
+
+// Fields returns every field of the IBAN keyed by its ibanFields letter, e.g. "b" for the bank code,
+// "t" for the account type, "m" for the currency or "i" for the holder's identification number.
+// The IBAN check digits ("k") are not included.
+func (i IBAN) Fields() map[string]string {
+	country, exists := countryList[i.CountryCode]
+	if !exists {
+		return nil
+	}
+
+	electronic := strings.ReplaceAll(i.Number, " ", "")
+	fields := make(map[string]string, len(country.fields))
+	for letter := range country.fields {
+		if letter != fieldChecksum {
+			fields[string(letter)] = country.fields.extract(electronic, letter)
+		}
+	}
+	return fields
+}
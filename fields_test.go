@@ -0,0 +1,76 @@
+package iban
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIBANFieldsMatchLength(t *testing.T) {
+	for code, country := range countryList {
+		if length := len(strings.ReplaceAll(country.ibanFields, " ", "")); length != country.chars {
+			t.Errorf("%s: ibanFields %q describes %d characters, expected %d", code, country.ibanFields, length, country.chars)
+		}
+	}
+}
+
+func TestIBANFieldsMatchNationalChecks(t *testing.T) {
+	for code, check := range nationalChecks {
+		positions := countryList[code].fields[fieldNationalCheck]
+		if len(positions) != check.Length || positions[0] != check.Offset+4 {
+			t.Errorf("%s: national check digits at %v in ibanFields, but the check uses offset %d and length %d",
+				code, positions, check.Offset, check.Length)
+		}
+	}
+}
+
+var fieldTestNumbers = []struct {
+	number        string
+	bankCode      string
+	branch        string
+	account       string
+	nationalCheck string
+	accountType   string
+}{
+	{"GB82 WEST 1234 5698 7654 32", "WEST", "123456", "98765432", "", ""},
+	{"AD1400080001001234567890", "0008", "0001", "001234567890", "", ""},
+	{"FR1420041010050500013M02606", "20041", "01005", "0500013M026", "06", ""},
+	{"IT60X0542811101000000123456", "05428", "11101", "000000123456", "X", ""},
+	{"ES9121000418450200051332", "2100", "0418", "0200051332", "45", ""},
+	{"BG18RZBB91550123456789", "RZBB", "9155", "23456789", "", "01"},
+	{"HU93116000060000000012345676", "116", "0000", "000000001234567", "66", ""},
+	{"DE91100000000123456789", "10000000", "", "0123456789", "", ""},
+	{"BE68539007547034", "539", "", "0075470", "34", ""},
+	{"IL170108000000012612345", "010", "800", "0000012612345", "", ""},
+}
+
+func TestIBANFields(t *testing.T) {
+	for _, test := range fieldTestNumbers {
+		result, err := NewIBAN(test.number)
+		if err != nil {
+			t.Errorf("%s: %v", test.number, err)
+			continue
+		}
+		if result.BankCode != test.bankCode || result.Branch != test.branch || result.Account != test.account ||
+			result.NationalCheck != test.nationalCheck || result.AccountType != test.accountType {
+			t.Errorf("%s: got %+v", test.number, result)
+		}
+	}
+}
+
+func TestFields(t *testing.T) {
+	result, err := NewIBAN("MU43 BOMM 0101 1234 5678 9101 000M UR")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fields := result.Fields()
+	if fields["m"] != "MUR" || fields["b"] != "BOMM01" || fields["s"] != "01" {
+		t.Errorf("got %v", fields)
+	}
+	if _, exists := fields["k"]; exists {
+		t.Errorf("the IBAN check digits should not be part of the fields: %v", fields)
+	}
+	if (IBAN{}).Fields() != nil {
+		t.Error("expected no fields for an empty IBAN")
+	}
+}
@@ -0,0 +1,86 @@
+package iban
+
+import "strings"
+
+// Match is an IBAN found in a text.
+type Match struct {
+	IBAN  IBAN // The IBAN found
+	Start int  // The byte offset of the first character of the IBAN in the text
+	End   int  // The byte offset just after the last character of the IBAN in the text
+}
+
+// FindAll returns the valid IBANs in a text, e.g. an email or the text of an invoice,
+// in the order they appear. The IBANs may be split by spaces, tabs, hyphens and a single
+// line break, and may be preceded by "IBAN" or "IBAN:". The offsets of a match cover the
+// IBAN from its country code to its last character, so text[m.Start:m.End] can be redacted
+// or highlighted. Candidates that fail any check of NewIBAN are skipped.
+func FindAll(text string) []Match {
+	var matches []Match
+	for start := 0; start+15 <= len(text); start++ {
+		if !isLetter(text[start]) || !isLetter(text[start+1]) {
+			continue
+		}
+		// An IBAN starts a word, unless it directly follows the IBAN prefix
+		if start > 0 && isAlphanumeric(text[start-1]) && (start < 4 || !strings.EqualFold(text[start-4:start], "IBAN")) {
+			continue
+		}
+		country, exists := countryList[strings.ToUpper(text[start:start+2])]
+		if !exists {
+			continue
+		}
+
+		number, end, found := collectIban(text, start, country.chars)
+		if !found {
+			continue
+		}
+		formatted, err := checkIban(number)
+		if err != nil {
+			continue
+		}
+		matches = append(matches, Match{IBAN: newIBAN(formatted), Start: start, End: end})
+		start = end - 1
+	}
+	return matches
+}
+
+// collectIban collects length alphanumeric characters from text, starting at start and skipping
+// separators after the country code. It returns the characters in upper case and the offset just
+// after the last one. It fails if the characters are followed directly by another alphanumeric character.
+func collectIban(text string, start, length int) (string, int, bool) {
+	number := make([]byte, 0, length)
+	lineBreaks := 0
+	i := start
+	for ; i < len(text) && len(number) < length; i++ {
+		char := text[i]
+		switch {
+		case isAlphanumeric(char):
+			if char >= 'a' && char <= 'z' {
+				char -= 'a' - 'A'
+			}
+			number = append(number, char)
+			lineBreaks = 0
+		case len(number) < 2:
+			return "", 0, false
+		case char == '\n':
+			if lineBreaks++; lineBreaks > 1 {
+				return "", 0, false
+			}
+		case char != ' ' && char != '\t' && char != '\r' && char != '-':
+			return "", 0, false
+		}
+	}
+	if len(number) < length || (i < len(text) && isAlphanumeric(text[i])) {
+		return "", 0, false
+	}
+	return string(number), i, true
+}
+
+// isLetter reports whether the character is an ASCII letter.
+func isLetter(char byte) bool {
+	return (char >= 'A' && char <= 'Z') || (char >= 'a' && char <= 'z')
+}
+
+// isAlphanumeric reports whether the character is an ASCII letter or digit.
+func isAlphanumeric(char byte) bool {
+	return isLetter(char) || (char >= '0' && char <= '9')
+}
@@ -0,0 +1,38 @@
+package iban
+
+import "testing"
+
+func TestFindAll(t *testing.T) {
+	text := "Dear customer,\nplease pay to IBAN: DE89 3704 0044 0532 0130 00 or to our British account\n" +
+		"GB82-WEST-1234-5698-7654-32 (not GB82 WEST 1234 5698 7654 33).\r\n" +
+		"Our Belgian account is IBANBE68539007547034, the Dutch one NL91 ABNA 0417\n164300.\n" +
+		"References like XDE89370400440532013000 or DE893704004405320130001 are not IBANs."
+	expected := []struct {
+		number string
+		span   string
+	}{
+		{"DE89 3704 0044 0532 0130 00", "DE89 3704 0044 0532 0130 00"},
+		{"GB82 WEST 1234 5698 7654 32", "GB82-WEST-1234-5698-7654-32"},
+		{"BE68 5390 0754 7034", "BE68539007547034"},
+		{"NL91 ABNA 0417 1643 00", "NL91 ABNA 0417\n164300"},
+	}
+
+	matches := FindAll(text)
+	if len(matches) != len(expected) {
+		t.Fatalf("expected %d matches, got %d: %v", len(expected), len(matches), matches)
+	}
+	for i, match := range matches {
+		if match.IBAN.Number != expected[i].number {
+			t.Errorf("match %d: expected %s, got %s", i, expected[i].number, match.IBAN.Number)
+		}
+		if span := text[match.Start:match.End]; span != expected[i].span {
+			t.Errorf("match %d: expected span %q, got %q", i, expected[i].span, span)
+		}
+	}
+}
+
+func TestFindAllParagraphBreak(t *testing.T) {
+	if matches := FindAll("DE89 3704 0044\n\n0532 0130 00"); len(matches) != 0 {
+		t.Errorf("expected no match across a paragraph break, got %v", matches)
+	}
+}
@@ -0,0 +1,265 @@
+package iban
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// germanAccountTests are the test account numbers published by the Deutsche Bundesbank
+// with the check methods, and the examples of the specification. The methods with variants
+// list the numbers of all variants; numbers that fail one variant but pass the next are valid.
+var germanAccountTests = []struct {
+	method   string
+	bankCode string
+	valid    []string
+	invalid  []string
+}{
+	{"00", "", []string{"9290701", "539290858", "1501824", "1501832"}, nil},
+	{"06", "", []string{"94012341", "5073321010"}, nil},
+	{"10", "", []string{"12345008", "87654008"}, nil},
+	{"17", "", []string{"0446786040"}, []string{"0446786240", "0478046340", "0701625730", "0701625440", "0882095130"}},
+	{"19", "", []string{"0240334000", "0200520016"}, nil},
+	{"24", "", []string{"138301", "1306118605", "3307118608", "9307118603"}, nil},
+	{"25", "", []string{"521382181"}, nil},
+	{"26", "", []string{"0520309001", "1111118111", "0005501024"}, nil},
+	{"27", "", []string{"2847169488"}, nil},
+	{"28", "", []string{"19999000", "9130000201"}, nil},
+	{"29", "", []string{"3145863029"}, nil},
+	{"31", "", []string{"1000000524", "1000000583"}, nil},
+	{"32", "", []string{"9141405", "1709107983", "0122116979", "0121114867", "9030101192", "9245500460"}, nil},
+	{"33", "", []string{"48658", "84956"}, nil},
+	{"34", "", []string{"9913000700", "9914001000"}, nil},
+	{"35", "", []string{"0000108443", "0000107451", "0000102921", "0000102349", "0000101709", "0000101599"}, nil},
+	{"36", "", []string{"113178", "146666"}, nil},
+	{"37", "", []string{"624315", "632500"}, nil},
+	{"38", "", []string{"191919", "1100660"}, nil},
+	{"39", "", []string{"200205", "10019400"}, nil},
+	{"40", "", []string{"1258345", "3231963"}, nil},
+	{"41", "", []string{"4013410024", "4016660195", "0166805317", "4019310079", "4019340829", "4019151002"}, nil},
+	{"42", "", []string{"59498", "59510"}, nil},
+	{"43", "", []string{"6135244", "9516893476"}, nil},
+	{"44", "", []string{"889006", "2618040504"}, nil},
+	{"45", "", []string{"3545343232", "4013410024", "0994681254", "0000012340", "1000199999", "0100114240"}, nil},
+	{"46", "", []string{"0235468612", "0837890901", "1041447600"}, nil},
+	{"47", "", []string{"1018000", "1003554450"}, nil},
+	{"50", "", []string{"4000005001", "4444442001"}, nil},
+	{"51", "", []string{"0001156071", "0001156136", "0000156078", "0001156078", "0001234567", "340968", "201178", "1009588",
+		"0000156071", "101356073", "0199100002", "0099100010", "2599100002", "0199100004", "2599100003", "3199204090"}, nil},
+	{"52", "13051172", []string{"43001500", "48726458"}, []string{"29837521", "82335729"}},
+	{"53", "16052072", []string{"382432256"}, nil},
+	{"54", "", []string{"4964137395", "4900010987"}, nil},
+	{"56", "", []string{"0290545005", "9718304037"}, nil},
+	{"57", "", []string{"7500021766", "9400001734", "7800028282", "8100244186", "3251080371", "3891234567", "7777778800",
+		"0185125434"}, []string{"5302707782", "6412121212", "1813499783", "2206735010"}},
+	{"58", "", []string{"1800293377", "1800881120", "9200654108", "1015222224", "3703169668"},
+		[]string{"1800881121", "9200654100", "1015222225", "3703169660"}},
+	{"61", "", []string{"2063099200", "0260760481"}, nil},
+	{"62", "", []string{"5029076701"}, nil},
+	{"63", "", []string{"123456600", "1234566"}, nil},
+	{"64", "", []string{"1206473010", "5016511020"}, nil},
+	{"65", "", []string{"1234567400", "1234567590"}, nil},
+	{"66", "", []string{"100154508", "101154508", "100154516", "101154516"}, []string{"100154509", "101154509", "100154500", "101154500"}},
+	{"68", "", []string{"8889654328", "987654324", "400000000"}, nil},
+	{"69", "", []string{"9721134869", "1234567900", "1234567006"}, nil},
+	{"71", "", []string{"7101234007"}, nil},
+	{"73", "", []string{"0003503398", "0001340967", "0003503391", "0001340968", "0003503392", "0001340966",
+		"0199100002", "0099100010", "2599100002", "0199100004", "2599100003", "3199204090"}, nil},
+	{"74", "", []string{"1016", "26260", "242243", "242248", "18002113", "1821200043"}, []string{"1011", "26265", "18002118", "6160000024"}},
+	{"76", "", []string{"0006543200", "9012345600", "7876543100"}, nil},
+	{"77", "", []string{"10338", "13844", "65354", "69258"}, nil},
+	{"79", "", []string{"3230012688", "4230028872", "5440001898", "6330001063", "7000149349", "8000003577",
+		"1550167850", "9011200140"}, nil},
+	{"80", "", []string{"340968", "340966"}, nil},
+	{"81", "", []string{"0646440", "1359100"}, nil},
+	{"83", "", []string{"0001156071", "0001156136", "0000156078", "0000156071", "0099100002"}, nil},
+	{"84", "", []string{"240699", "350982", "461059", "240692", "350985", "461052", "350984", "461054"},
+		[]string{"240965", "350980", "461053"}},
+	{"85", "", []string{"0001156071", "0001156136", "0000156078", "0000156071", "3199100002"}, nil},
+	{"86", "", []string{"340968", "1001171", "1009588", "123897", "340960"}, nil},
+	{"87", "", []string{"0000000406", "0000051768", "0010701590", "0010720185", "0000100005", "0000393814",
+		"0000950360", "3199500501"}, nil},
+	{"88", "", []string{"2525259", "1000500", "90013000", "92525253", "99913003"}, nil},
+	{"90", "", []string{"0001975641", "0001988654", "0001863530", "0001784451", "0000654321", "0000824491",
+		"0000677747", "0000840507", "0000996663", "0000666034", "0099100002"},
+		[]string{"0001924592", "0000901568", "0000820487", "0000726393", "0000924591", "0099100007"}},
+	{"91", "", []string{"2974118000", "5281741000", "9952810000", "2974117000", "5281770000", "9952812000",
+		"8840019000", "8840050000", "8840087000", "8840045000", "8840012000", "8840055000", "8840080000"},
+		[]string{"8840017000", "8840023000", "8840041000", "8840014000", "8840026000", "8840011000", "8840025000",
+			"8840062000", "8840010000", "8840057000"}},
+	{"93", "", []string{"6714790000", "0000671479"}, nil},
+	{"94", "", []string{"6782533003"}, nil},
+	{"95", "", []string{"0068007003", "0847321750", "6450060494", "6454000003"}, nil},
+	{"96", "", []string{"0000254100", "9421000009", "0000000208", "0101115152", "0301204301", "0001300000", "0099399999"}, nil},
+	{"97", "", []string{"24010019"}, nil},
+	{"98", "", []string{"9619439213", "3009800016", "9619509976", "5989800173", "6719430018"}, nil},
+	{"99", "", []string{"0068007003", "0847321750"}, nil},
+	{"A0", "", []string{"521003287", "54500", "3287", "18761", "28290"}, nil},
+	{"A1", "", []string{"0010030005", "0010030997", "1010030054"}, []string{"0110030005", "0010030998", "0000030005"}},
+	{"A2", "", []string{"3456789019", "5678901231", "6789012348", "3456789012"}, []string{"1234567890", "0123456789"}},
+	{"A3", "", []string{"1234567897", "0123456782", "9876543210", "1234567890", "0123456789"}, []string{"6543217890", "0543216789"}},
+	{"A4", "", []string{"0004711173", "0007093330", "0004711172", "0007093335", "1199503010", "8499421235",
+		"0000862342", "8997710000", "0664040000", "0000905844", "5030101099", "0001123458", "1299503117"}, nil},
+	{"A5", "", []string{"9941510001", "9961230019", "9380027210", "9932290910", "0000251437", "0007948344",
+		"0000159590", "0000051640"}, []string{"9941510002", "9961230020", "0000251438", "0007948345"}},
+	{"A6", "", []string{"800048548", "0855000014", "17", "55300030", "150178033", "600003555", "900291823"},
+		[]string{"860000817", "810033652", "305888", "200071280"}},
+	{"A7", "", []string{"19010008", "19010438", "19010660", "19010876", "209010892"}, []string{"209010893"}},
+	{"A8", "", []string{"7436661", "7436670", "1359100", "7436660", "7436678", "0003503398", "0001340967",
+		"0199100002", "0099100010", "2599100002", "0199100004", "2599100003", "3199204090"},
+		[]string{"7436666", "7436677", "0003503391", "0001340968"}},
+	{"A9", "", []string{"5043608", "86725", "504360", "822035", "32577083"}, []string{"86724", "292497", "30767208"}},
+	{"B0", "", []string{"1197423162", "1000000606", "1000000406", "1035791538", "1126939724", "1197423460"},
+		[]string{"8137423260", "600000606", "51234309", "1000000405", "1035791539"}},
+	{"B1", "", []string{"1434253150", "2746315471", "7414398260", "8347251693"}, []string{"0123456789", "2345678901", "5678901234"}},
+	{"B2", "", []string{"0020012357", "0080012345", "0926801910", "1002345674", "8000990054", "9000481805"},
+		[]string{"0020012399", "0080012347", "0080012370", "0932100027", "3310123454", "8000990057", "8011000126",
+			"9000481800", "9980480111"}},
+	{"B3", "", []string{"1000000060", "0140000019", "1002798417", "8409915001", "9635000101", "9730200100"},
+		[]string{"2799899999", "1000000111", "9635100101", "9730300100"}},
+	{"B4", "", []string{"9941510001", "9961230019", "9380027210", "9932290910", "0000251437", "0007948344", "0000051640"},
+		[]string{"9941510002", "9961230020", "0000251438", "0007948345"}},
+	{"B5", "", []string{"0159006955", "2000123451", "1151043216", "9000939033", "0123456782", "0130098767", "1045000252"},
+		[]string{"7414398260", "8347251693", "2345678901", "5678901234", "9000293707", "0159004165", "0023456787",
+			"0056789018", "3045000333"}},
+	{"B6", "", []string{"9110000000", "0269876545"}, []string{"9111000000", "0269456780"}},
+	{"B6", "80053782", []string{"487310018"}, []string{"467310018", "477310018"}},
+	{"B7", "", []string{"0700001529", "0730000019", "0001001008", "0001057887", "0001007222", "0810011825",
+		"0800107653", "0005922372"}, []string{"0001057886", "0003815570", "0005620516", "0740912243", "0893524479"}},
+	{"B8", "", []string{"0734192657", "6932875274", "3145863029", "2938692523"}, []string{"0132572975", "3038752371"}},
+	{"B9", "", []string{"87920187", "41203755", "81069577", "61287958", "58467232", "7125633", "1253657", "4353631"},
+		[]string{"88034023", "43025432", "86521362", "2345678", "5678901", "9012345"}},
+	{"C0", "13051172", []string{"43001500", "48726458", "82335729", "0734192657", "6932875274"},
+		[]string{"29837521", "0132572975", "3038752371"}},
+	{"C1", "", []string{"0446786040", "0478046940", "0701625830", "0701625840", "0882095630", "5432112349",
+		"5543223456", "5654334563", "5765445670", "5876556788"},
+		[]string{"0446786240", "0478046340", "0701625730", "0701625440", "0882095130", "5432112341", "5543223458",
+			"5654334565", "5765445672", "5876556780"}},
+	{"C2", "", []string{"2394871426", "4218461950", "7352569148", "5127485166", "8738142564"}, []string{"0328705282", "9024675131"}},
+	{"C3", "", []string{"9294182", "4431276", "19919", "9000420530", "9000010006", "9000577650"},
+		[]string{"17002", "123451", "122448", "9000734028", "9000733227", "9000731120"}},
+	{"C4", "", []string{"0000000019", "0000292932", "0000094455", "9000420530", "9000010006", "9000577650"},
+		[]string{"0000000017", "0000292933", "0000094459", "9000726558", "9001733457", "9000732000"}},
+	{"C5", "", []string{"0000301168", "0000302554", "0300020050", "0300566000", "1000061378", "1000061412",
+		"4450164064", "4863476104", "5000000028", "5000000391", "6450008149", "6800001016", "9000100012",
+		"9000210017", "3060188103", "3070402023"},
+		[]string{"0000302589", "0000507336", "0302555000", "0302589000", "1000061457", "1000061498", "4864446015",
+			"4865038012", "5000001028", "5000001075", "6450008150", "6542812818", "9000110012", "9000300310",
+			"3081000783", "3081308871"}},
+	{"C6", "", []string{"0000065516", "0203178249", "1031405209", "1082012201", "2003455189", "2004001016",
+		"3110150986", "3068459207", "5035105948", "5286102149", "4012660028", "4100235626", "6028426119",
+		"6861001755", "7008199027", "7002000023", "8526080015", "8711072264", "9000430223", "9000781153"},
+		[]string{"0525111212", "0091423614", "1082311275", "1000118821", "2004306518", "2016001206", "3462816371",
+			"3622548632", "4232300158", "4000456126", "5002684526", "5564123850", "6295473774", "6640806317",
+			"7000062022", "7006003027", "8654216984", "9000641509", "9000260983"}},
+	{"C7", "", []string{"3500022", "38150900", "600103660", "39101181", "94012341", "5073321010"},
+		[]string{"1234517892", "987614325"}},
+	{"C8", "", []string{"3456789019", "5678901231", "3456789012", "0022007130", "0123456789", "0552071285"},
+		[]string{"1234567890", "9012345678"}},
+	{"C9", "", []string{"3456789019", "5678901231", "0123456789", "0552071285"}, []string{"3456789012", "0022007130"}},
+	{"D0", "", []string{"6100272324", "6100273479", "5700000000", "5799999999"}, []string{"6100272885", "6100273377", "6100274012"}},
+	{"D1", "", []string{"0082012203", "1452683581", "2129642505", "3002000027", "4230001407", "5000065514",
+		"6001526215", "7126502149", "9000430223"},
+		[]string{"0000260986", "1062813622", "2256412314", "3012084101", "4006003027", "5814500990", "6128462594",
+			"7000062035", "8003306026", "9000641509"}},
+	{"D2", "", []string{"189912137", "235308215", "4455667784", "1234567897"}, []string{"6414241"}},
+	{"D3", "", []string{"1600169591", "1600189151", "1800084079", "1600176485", "1600201934", "6019937007",
+		"6021354007", "6030642006"}, []string{"1600166307", "6025017009", "6028267003", "6019835001"}},
+	{"D4", "", []string{"1112048219", "2024601814", "3000005012", "4143406984", "5926485111", "6286304975",
+		"7900256617", "8102228628", "9002364588"},
+		[]string{"0359432843", "1000062023", "2204271250", "3051681017", "4000123456", "5212744564", "6286420010",
+			"7859103459", "8003306026", "9916524534"}},
+	{"D5", "", []string{"5999718138", "1799222116", "0099632004", "0004711173", "0007093330", "0000127787"},
+		[]string{"3299632008", "1999204293", "0399242139"}},
+	{"D6", "", []string{"3409", "585327", "1650513", "3601671056", "4402001046", "6100125001"}, []string{"33394", "595795", "16400501"}},
+	{"D7", "", []string{"0500018205", "0230103715", "0301000434", "0330035104", "0420001202", "0134637709",
+		"0201005939", "0602006999"},
+		[]string{"0501006102", "0231307867", "0301005331", "0330034104", "0420001302", "0135638809", "0202005939",
+			"0601006977"}},
+	{"D8", "", []string{"1403414848", "6800000439", "6899999954", "0010000000", "0099999999"}, []string{"3012084101", "1062813622", "0260986"}},
+	{"D9", "", []string{"1234567897", "0123456782", "9876543210", "1234567890", "0123456789", "1100132044", "1100669030"},
+		[]string{"6543210987", "4321098765", "1100789043", "1100914032"}},
+	{"E0", "", []string{"1234568013", "1534568010", "2610015", "8741013011"}, []string{"1234769013", "2710014", "9741015011"}},
+	{"E1", "", []string{"0134211909", "0100041104", "0100054106", "0200025107"},
+		[]string{"0150013107", "0200035101", "0081313890", "4268550840", "0987402008"}},
+	{"E2", "", []string{"0003831745", "0051330335"}, []string{"0003831746", "6003831745"}},
+	{"E3", "", []string{"9290701", "539290858", "1501824", "1501832"}, nil},
+}
+
+func TestCheckGermanAccount(t *testing.T) {
+	for _, test := range germanAccountTests {
+		for _, account := range test.valid {
+			if valid, err := CheckGermanAccount(test.method, test.bankCode, account); !valid || err != nil {
+				t.Errorf("method %s: %s was rejected (%v)", test.method, account, err)
+			}
+		}
+		for _, account := range test.invalid {
+			if valid, err := CheckGermanAccount(test.method, test.bankCode, account); valid || err != nil {
+				t.Errorf("method %s: %s was accepted (%v)", test.method, account, err)
+			}
+		}
+	}
+}
+
+func TestGermanCheckMethods(t *testing.T) {
+	// Every method from 00 to E4 is implemented, except 12, which is not assigned
+	var methods []string
+	for i := 0; i <= 99; i++ {
+		methods = append(methods, fmt.Sprintf("%02d", i))
+	}
+	for _, letter := range "ABCD" {
+		for i := 0; i <= 9; i++ {
+			methods = append(methods, fmt.Sprintf("%c%d", letter, i))
+		}
+	}
+	methods = append(methods, "E0", "E1", "E2", "E3", "E4")
+	for _, method := range methods {
+		_, err := CheckGermanAccount(method, "37040044", "0532013000")
+		if (err != nil) != (method == "12") {
+			t.Errorf("method %s: got %v", method, err)
+		}
+	}
+
+	for _, test := range []struct{ method, account string }{{"E5", "0532013000"}, {"00", ""}, {"00", "12345678901"}, {"00", "12345A"}} {
+		if _, err := CheckGermanAccount(test.method, "", test.account); err == nil {
+			t.Errorf("%s %q: no error", test.method, test.account)
+		}
+	}
+}
+
+func TestWithGermanAccountCheck(t *testing.T) {
+	directory := NewDirectory([]Bank{{Country: "DE", BankCode: "37040044", Name: "Commerzbank", CheckMethod: "13"}})
+	validator := NewValidator(WithGermanAccountCheck(directory))
+	if _, err := validator.Validate("DE89 3704 0044 0532 0130 00"); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	// The IBAN checksum is right, but the account number fails method 13
+	invalid, err := Build("DE", Components{BankCode: "37040044", Account: "0532013100"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = validator.Validate(invalid.Electronic())
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Reason != BadAccountCheckDigit || validationErr.Position != 12 ||
+		validationErr.Actual != "0532013100" || !errors.Is(err, ErrInvalidIBAN) {
+		t.Errorf("got %v", err)
+	}
+	if _, err := NewIBAN(invalid.Electronic()); err != nil {
+		t.Errorf("NewIBAN does not check account numbers: %v", err)
+	}
+
+	// Banks that are not in the directory are not checked
+	unknown, _ := Build("DE", Components{BankCode: "10020000", Account: "0532013100"})
+	if _, err := validator.Validate(unknown.Electronic()); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	// Neither are banks whose check method is unknown, e.g. a method of a newer Bundesbank file
+	for _, method := range []string{"12", "F9", "1"} {
+		directory := NewDirectory([]Bank{{Country: "DE", BankCode: "37040044", Name: "Commerzbank", CheckMethod: method}})
+		if _, err := NewValidator(WithGermanAccountCheck(directory)).Validate(invalid.Electronic()); err != nil {
+			t.Errorf("method %s: unexpected error %v", method, err)
+		}
+	}
+}
@@ -4,104 +4,172 @@ package iban
 import (
 	"errors"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 )
 
-// ErrInvalidIBAN is returned when an invalid IBAN number was received
-var ErrInvalidIBAN = errors.New("invalid IBAN number received")
-
 // IBAN represents an IBAN number, split up into its different parts.
 type IBAN struct {
-	Number      string // The full IBAN number
-	CountryCode string // The country code of the IBAN
-	Checksum    string // The checksum of the IBAN
-	BBAN        string // The Basic Bank Account Number (BBAN) of the IBAN
-	BankCode    string // The bank code extracted from the IBAN
+	Number        string // The full IBAN number
+	CountryCode   string // The country code of the IBAN
+	Checksum      string // The checksum of the IBAN
+	BBAN          string // The Basic Bank Account Number (BBAN) of the IBAN
+	BankCode      string // The bank code extracted from the IBAN
+	Branch        string // The branch code extracted from the IBAN, if the country has one
+	Account       string // The account number extracted from the IBAN
+	NationalCheck string // The national check digits extracted from the IBAN, if the country has them
+	AccountType   string // The account type extracted from the IBAN, if the country has one
 }
 
 // NewIBAN creates a new instance of IBAN and checks if the IBAN number is valid.
 // If the IBAN is valid, it returns the IBAN struct with its different parts filled in.
+// Otherwise it returns a *ValidationError, which wraps ErrInvalidIBAN.
+// It uses the default Validator, which applies no policies beyond the IBAN rules.
 func NewIBAN(ibanNumber string) (IBAN, error) {
-	isIBANValid, formattedIBANNumber, err := IsCorrectIban(ibanNumber, false)
-	if err != nil || !isIBANValid {
-		return IBAN{}, fmt.Errorf("%w: %v", ErrInvalidIBAN, err)
-	}
+	return defaultValidator.Validate(ibanNumber)
+}
 
-	countryCode, checksum, bban := splitIbanUp(formattedIBANNumber)
-	bankCode, _ := getBankCode(countryCode, formattedIBANNumber)
+// newIBAN splits a valid, formatted IBAN number up into its different parts.
+func newIBAN(formattedIBANNumber string) IBAN {
+	electronicIBAN := strings.ReplaceAll(formattedIBANNumber, " ", "")
+	countryCode, checksum, bban := splitIbanUp(electronicIBAN)
+	fields := countryList[countryCode].fields
 
 	return IBAN{
-		Number:      formattedIBANNumber,
-		CountryCode: countryCode,
-		Checksum:    checksum,
-		BBAN:        bban,
-		BankCode:    bankCode,
-	}, nil
-}
-
-// getBankCode extracts the bank code from the IBAN based on the country configuration.
-func getBankCode(countryCode, ibanNumber string) (string, error) {
-	if code, exists := countryList[countryCode]; exists {
-		firstIndex := strings.Index(code.ibanFields, "b")
-		lastIndex := strings.LastIndex(code.ibanFields, "b")
-		if firstIndex != -1 && lastIndex != -1 {
-			return ibanNumber[firstIndex : lastIndex+1], nil
-		}
+		Number:        formattedIBANNumber,
+		CountryCode:   countryCode,
+		Checksum:      checksum,
+		BBAN:          bban,
+		BankCode:      fields.extract(electronicIBAN, fieldBank),
+		Branch:        fields.extract(electronicIBAN, fieldBranch),
+		Account:       fields.extract(electronicIBAN, fieldAccount),
+		NationalCheck: fields.extract(electronicIBAN, fieldNationalCheck),
+		AccountType:   fields.extract(electronicIBAN, fieldAccountType),
 	}
-	return "", errors.New("no bank code found")
 }
 
+//go:generate go run ./internal/cmd/gencountrylist -supplement data/countries.csv -registry data/swift_iban_registry.txt -out countryList.go
+
 type ibanCountry struct {
-	country           string // The country name
-	chars             int    // The expected length of the IBAN for this country
-	bbanFormat        string // The format of the BBAN part of the IBAN
-	code              string // The country code
-	ibanFields        string // The fields of the IBAN (e.g., bank code, branch code)
-	comment           string // Additional comments about the IBAN format
-	standardTreatment bool   // Indicates if the country follows the standard treatment
+	country        string // The country name
+	chars          int    // The expected length of the IBAN for this country
+	bbanFormat     string // The format of the BBAN part of the IBAN
+	code           string // The country code
+	ibanFields     string // The fields of the IBAN (e.g., bank code, branch code)
+	comment        string // Additional comments about the IBAN format
+	fixedChecksum  string // The IBAN check digits if they are a constant for this country, empty otherwise
+	example        string // An example IBAN of this country
+	bbanExample    string // An example BBAN of this country
+	bankPosition   [2]int // The first and last position (starting at 1) of the bank identifier in the BBAN
+	branchPosition [2]int // The first and last position (starting at 1) of the branch identifier in the BBAN
+	sepa           bool   // Indicates if the country is part of the Single Euro Payments Area
+	effectiveDate  string // The date from which the IBAN format is in effect, as given by the registry
+
+	structure bbanStructure // The compiled bbanFormat, filled in at package initialization
+	fields    fieldMap      // The compiled ibanFields, filled in at package initialization
+}
+
+// init compiles the bbanFormat and the ibanFields of every country in the list.
+func init() {
+	for code, country := range countryList {
+		structure, err := parseBBANFormat(country.bbanFormat)
+		if err != nil {
+			panic(fmt.Sprintf("iban: country %s: %v", code, err))
+		}
+		country.structure = structure
+		country.fields = parseIBANFields(country.ibanFields)
+		countryList[code] = country
+	}
 }
 
 // IsCorrectIban checks if the given IBAN number corresponds to the rules of a valid IBAN number.
 // It also returns a properly formatted IBAN number string.
+// When the IBAN is rejected the error is a *ValidationError telling the reason.
+// The result is logged to the logger set with SetLogger; debug raises the level of the events.
+// It uses the default Validator, which applies no policies beyond the IBAN rules.
 func IsCorrectIban(iban string, debug bool) (bool, string, error) {
-	obscureIban := func(iban string) string {
-		if len(iban) <= 6 {
-			return iban
-		}
-		return iban[:2] + strings.Repeat("*", len(iban)-6) + iban[len(iban)-4:]
+	result, err := defaultValidator.validate(iban, debug)
+	if err != nil {
+		return false, "", err
 	}
+	return true, result.Number, nil
+}
 
+// checkIban validates the IBAN number and returns it formatted in groups of four characters.
+func checkIban(iban string) (string, error) {
 	// Clean up and standardize the IBAN string
-	iban = strings.ToUpper(strings.ReplaceAll(iban, " ", ""))
+	iban, _ = Normalize(iban)
 	if len(iban) < 15 {
-		log.Printf("Incorrect IBAN string passed: %s", obscureIban(iban))
-		return false, "", fmt.Errorf("IBAN: incorrect IBAN string passed <%s>", obscureIban(iban))
+		return "", &ValidationError{Reason: TooShort, Expected: "15", Actual: obscureIban(iban), Position: -1}
+	}
+	for i := 0; i < len(iban); i++ {
+		if !matchesClass(iban[i], classAlphaNumeric) {
+			return "", &ValidationError{Reason: IllegalCharacter, Actual: string(iban[i]), Position: i}
+		}
 	}
 
 	// Split the IBAN into its parts
 	countryCode, checksum, bban := splitIbanUp(iban)
 	ibanConfig, exists := countryList[countryCode]
 	if !exists {
-		log.Printf("Invalid IBAN country: IBAN %s has country code not in the list (%s)", obscureIban(iban), countryCode)
-		return false, "", fmt.Errorf("IBAN: country <%s> is not in the list", countryCode)
+		return "", &ValidationError{Reason: UnknownCountry, Country: countryCode, Actual: countryCode, Position: 0}
 	}
 
 	// Check if the length matches the expected length for the country
 	if ibanConfig.chars != len(iban) {
-		log.Printf("Invalid IBAN length: IBAN %s length (%d) does not match configuration length (%d)", obscureIban(iban), len(iban), ibanConfig.chars)
-		return false, "", fmt.Errorf("IBAN: length (%d) does not match configuration length (%d)", len(iban), ibanConfig.chars)
+		return "", &ValidationError{
+			Reason:   WrongLength,
+			Country:  countryCode,
+			Expected: strconv.Itoa(ibanConfig.chars),
+			Actual:   strconv.Itoa(len(iban)),
+			Position: -1,
+		}
 	}
 
-	// Rearrange the IBAN for validation and convert characters to numbers
-	rearrangedIban := rearrangeIBAN(countryCode, checksum, bban)
-	convertedIban := convertCharToNumber(rearrangedIban)
-	if calculateModulo(convertedIban) != 1 {
-		return false, "", nil
+	// Check every character of the BBAN against the country's format
+	if err := ibanConfig.structure.check(countryCode, bban); err != nil {
+		var formatErr *FormatError
+		errors.As(err, &formatErr)
+		return "", &ValidationError{
+			Reason:   BadBBANFormat,
+			Country:  countryCode,
+			Expected: formatErr.Format,
+			Actual:   string(formatErr.Char),
+			Position: formatErr.Position,
+			Err:      err,
+		}
+	}
+
+	// Some countries always use the same IBAN check digits
+	if ibanConfig.fixedChecksum != "" && ibanConfig.fixedChecksum != checksum {
+		return "", &ValidationError{
+			Reason:   BadFixedChecksum,
+			Country:  countryCode,
+			Expected: ibanConfig.fixedChecksum,
+			Actual:   checksum,
+			Position: 2,
+		}
 	}
 
-	return true, splitTo4(iban), nil
+	// Validate the IBAN checksum over the rearranged IBAN; the characters were checked above
+	rest, _ := calculateModulo(countryCode, checksum, bban)
+	if !matchesClass(checksum[0], classNumeric) || !matchesClass(checksum[1], classNumeric) || rest != 1 {
+		rest, _ = calculateModulo(countryCode, "00", bban)
+		return "", &ValidationError{
+			Reason:   BadChecksum,
+			Country:  countryCode,
+			Expected: twoDigits(98 - rest),
+			Actual:   checksum,
+			Position: 2,
+		}
+	}
+
+	// Verify the national check digits of the BBAN, if the country has them
+	if err := checkNationalDigits(countryCode, bban); err != nil {
+		return "", err
+	}
+
+	return splitTo4(iban), nil
 }
 
 // splitIbanUp splits the IBAN into its country code, checksum, and BBAN parts.
@@ -125,55 +193,21 @@ func splitTo4(value string) string {
 // GetIbanChecksum returns the checksum of the given IBAN number.
 func GetIbanChecksum(iban string) (int, error) {
 	// Clean up and standardize the IBAN string
-	iban = strings.ToUpper(strings.ReplaceAll(iban, " ", ""))
+	iban, _ = Normalize(iban)
 	if len(iban) < 15 {
-		log.Printf("Incorrect IBAN string passed for checksum calculation: %s", obscureIban(iban))
-		return -1, fmt.Errorf("IBAN: incorrect IBAN string passed <%s>", obscureIban(iban))
+		err := &ValidationError{Reason: TooShort, Expected: "15", Actual: obscureIban(iban), Position: -1}
+		logResult(false, iban, err)
+		return -1, err
 	}
 
-	// Rearrange the IBAN for checksum calculation
+	// Calculate the checksum over the rearranged IBAN with "00" as check digits
 	countryCode, _, bban := splitIbanUp(iban)
-	rearrangedIban := rearrangeIBAN(countryCode, "00", bban)
-	convertedIban := convertCharToNumber(rearrangedIban)
-	return 98 - calculateModulo(convertedIban), nil
-}
-
-// convertCharToNumber converts alphabetic characters in the IBAN to their corresponding numeric values.
-func convertCharToNumber(value string) string {
-	var builder strings.Builder
-	for _, item := range value {
-		if item >= 'A' && item <= 'Z' {
-			builder.WriteString(strconv.Itoa(int(item - 'A' + 10)))
-		} else {
-			builder.WriteRune(item)
-		}
-	}
-	return builder.String()
-}
-
-// rearrangeIBAN rearranges the IBAN by moving the country code and checksum to the end.
-func rearrangeIBAN(countryCode, checksum, bban string) string {
-	return bban + countryCode + checksum
-}
-
-// calculateModulo calculates the modulo 97 of the given IBAN number.
-func calculateModulo(iban string) int {
-	for len(iban) > 9 {
-		tempIBAN := iban[:9]
-		value, err := strconv.Atoi(tempIBAN)
-		if err != nil {
-			return -1
-		}
-		rest := value % 97
-		iban = strconv.Itoa(rest) + iban[9:]
-	}
-
-	value, err := strconv.Atoi(iban)
+	rest, err := calculateModulo(countryCode, "00", bban)
 	if err != nil {
-		return -1
+		logResult(false, iban, err)
+		return -1, err
 	}
-
-	return value % 97
+	return 98 - rest, nil
 }
 
 // obscureIban obscures the middle part of an IBAN for logging purposes.
@@ -16,6 +16,7 @@ var invalidIBANTestNumbers = []struct {
 	number string
 }{
 	{"LU12 3456 7890 1234 5678"},
+	{"IL68 LUMI 8000 0001 2612 345"},
 }
 
 func TestValidIBAN(t *testing.T) {
@@ -0,0 +1,5 @@
+// Package ibanpb holds the gRPC service definition of the IBAN rules and its generated Go stubs.
+// The service is implemented by cmd/iban-grpc-server.
+package ibanpb
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative ../ibanpb/iban.proto
@@ -0,0 +1,827 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: ibanpb/iban.proto
+
+package ibanpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Reason tells why an IBAN was rejected. The values match iban.Reason.
+type Reason int32
+
+const (
+	Reason_REASON_UNSPECIFIED              Reason = 0
+	Reason_REASON_TOO_SHORT                Reason = 1
+	Reason_REASON_UNKNOWN_COUNTRY          Reason = 2
+	Reason_REASON_WRONG_LENGTH             Reason = 3
+	Reason_REASON_BAD_CHECKSUM             Reason = 4
+	Reason_REASON_BAD_BBAN_FORMAT          Reason = 5
+	Reason_REASON_BAD_NATIONAL_CHECK_DIGIT Reason = 6
+	Reason_REASON_ILLEGAL_CHARACTER        Reason = 7
+	Reason_REASON_BAD_FIXED_CHECKSUM       Reason = 8
+	Reason_REASON_COUNTRY_NOT_ALLOWED      Reason = 9
+	Reason_REASON_NOT_SEPA                 Reason = 10
+	Reason_REASON_NATIONAL_CHECK_REQUIRED  Reason = 11
+	Reason_REASON_NOT_ELECTRONIC_FORMAT    Reason = 12
+	Reason_REASON_RULE_VIOLATION           Reason = 13
+	Reason_REASON_BAD_ACCOUNT_CHECK_DIGIT  Reason = 14
+)
+
+// Enum value maps for Reason.
+var (
+	Reason_name = map[int32]string{
+		0:  "REASON_UNSPECIFIED",
+		1:  "REASON_TOO_SHORT",
+		2:  "REASON_UNKNOWN_COUNTRY",
+		3:  "REASON_WRONG_LENGTH",
+		4:  "REASON_BAD_CHECKSUM",
+		5:  "REASON_BAD_BBAN_FORMAT",
+		6:  "REASON_BAD_NATIONAL_CHECK_DIGIT",
+		7:  "REASON_ILLEGAL_CHARACTER",
+		8:  "REASON_BAD_FIXED_CHECKSUM",
+		9:  "REASON_COUNTRY_NOT_ALLOWED",
+		10: "REASON_NOT_SEPA",
+		11: "REASON_NATIONAL_CHECK_REQUIRED",
+		12: "REASON_NOT_ELECTRONIC_FORMAT",
+		13: "REASON_RULE_VIOLATION",
+		14: "REASON_BAD_ACCOUNT_CHECK_DIGIT",
+	}
+	Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED":              0,
+		"REASON_TOO_SHORT":                1,
+		"REASON_UNKNOWN_COUNTRY":          2,
+		"REASON_WRONG_LENGTH":             3,
+		"REASON_BAD_CHECKSUM":             4,
+		"REASON_BAD_BBAN_FORMAT":          5,
+		"REASON_BAD_NATIONAL_CHECK_DIGIT": 6,
+		"REASON_ILLEGAL_CHARACTER":        7,
+		"REASON_BAD_FIXED_CHECKSUM":       8,
+		"REASON_COUNTRY_NOT_ALLOWED":      9,
+		"REASON_NOT_SEPA":                 10,
+		"REASON_NATIONAL_CHECK_REQUIRED":  11,
+		"REASON_NOT_ELECTRONIC_FORMAT":    12,
+		"REASON_RULE_VIOLATION":           13,
+		"REASON_BAD_ACCOUNT_CHECK_DIGIT":  14,
+	}
+)
+
+func (x Reason) Enum() *Reason {
+	p := new(Reason)
+	*p = x
+	return p
+}
+
+func (x Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_ibanpb_iban_proto_enumTypes[0].Descriptor()
+}
+
+func (Reason) Type() protoreflect.EnumType {
+	return &file_ibanpb_iban_proto_enumTypes[0]
+}
+
+func (x Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Reason.Descriptor instead.
+func (Reason) EnumDescriptor() ([]byte, []int) {
+	return file_ibanpb_iban_proto_rawDescGZIP(), []int{0}
+}
+
+// ValidationError describes why an IBAN was rejected.
+type ValidationError struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Reason Reason                 `protobuf:"varint,1,opt,name=reason,proto3,enum=iban.v1.Reason" json:"reason,omitempty"`
+	// The reason as named by iban.Reason, e.g. "BadChecksum".
+	ReasonName string `protobuf:"bytes,2,opt,name=reason_name,json=reasonName,proto3" json:"reason_name,omitempty"`
+	Country    string `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	Expected   string `protobuf:"bytes,4,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual     string `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`
+	// The index of the offending character in the electronic IBAN, -1 if none.
+	Position      int32  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	Message       string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationError) Reset() {
+	*x = ValidationError{}
+	mi := &file_ibanpb_iban_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationError) ProtoMessage() {}
+
+func (x *ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_ibanpb_iban_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationError.ProtoReflect.Descriptor instead.
+func (*ValidationError) Descriptor() ([]byte, []int) {
+	return file_ibanpb_iban_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidationError) GetReason() Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Reason_REASON_UNSPECIFIED
+}
+
+func (x *ValidationError) GetReasonName() string {
+	if x != nil {
+		return x.ReasonName
+	}
+	return ""
+}
+
+func (x *ValidationError) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ValidationError) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *ValidationError) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+func (x *ValidationError) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ValidationError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// IBAN is a valid IBAN and its parts.
+type IBAN struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Upper case and without spaces.
+	Electronic string `protobuf:"bytes,1,opt,name=electronic,proto3" json:"electronic,omitempty"`
+	// In groups of four characters.
+	Formatted     string `protobuf:"bytes,2,opt,name=formatted,proto3" json:"formatted,omitempty"`
+	CountryCode   string `protobuf:"bytes,3,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	CheckDigits   string `protobuf:"bytes,4,opt,name=check_digits,json=checkDigits,proto3" json:"check_digits,omitempty"`
+	Bban          string `protobuf:"bytes,5,opt,name=bban,proto3" json:"bban,omitempty"`
+	BankCode      string `protobuf:"bytes,6,opt,name=bank_code,json=bankCode,proto3" json:"bank_code,omitempty"`
+	Branch        string `protobuf:"bytes,7,opt,name=branch,proto3" json:"branch,omitempty"`
+	Account       string `protobuf:"bytes,8,opt,name=account,proto3" json:"account,omitempty"`
+	NationalCheck string `protobuf:"bytes,9,opt,name=national_check,json=nationalCheck,proto3" json:"national_check,omitempty"`
+	AccountType   string `protobuf:"bytes,10,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	// All fields by their letter in the field layout of the country, e.g. "b" for the bank code.
+	Fields        map[string]string `protobuf:"bytes,11,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IBAN) Reset() {
+	*x = IBAN{}
+	mi := &file_ibanpb_iban_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IBAN) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IBAN) ProtoMessage() {}
+
+func (x *IBAN) ProtoReflect() protoreflect.Message {
+	mi := &file_ibanpb_iban_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IBAN.ProtoReflect.Descriptor instead.
+func (*IBAN) Descriptor() ([]byte, []int) {
+	return file_ibanpb_iban_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IBAN) GetElectronic() string {
+	if x != nil {
+		return x.Electronic
+	}
+	return ""
+}
+
+func (x *IBAN) GetFormatted() string {
+	if x != nil {
+		return x.Formatted
+	}
+	return ""
+}
+
+func (x *IBAN) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *IBAN) GetCheckDigits() string {
+	if x != nil {
+		return x.CheckDigits
+	}
+	return ""
+}
+
+func (x *IBAN) GetBban() string {
+	if x != nil {
+		return x.Bban
+	}
+	return ""
+}
+
+func (x *IBAN) GetBankCode() string {
+	if x != nil {
+		return x.BankCode
+	}
+	return ""
+}
+
+func (x *IBAN) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *IBAN) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *IBAN) GetNationalCheck() string {
+	if x != nil {
+		return x.NationalCheck
+	}
+	return ""
+}
+
+func (x *IBAN) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *IBAN) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ValidateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IBAN in any format accepted by iban.Normalize.
+	Iban          string `protobuf:"bytes,1,opt,name=iban,proto3" json:"iban,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_ibanpb_iban_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ibanpb_iban_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_ibanpb_iban_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidateRequest) GetIban() string {
+	if x != nil {
+		return x.Iban
+	}
+	return ""
+}
+
+type ValidateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Input string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Valid bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Set if the IBAN is valid.
+	Iban *IBAN `protobuf:"bytes,3,opt,name=iban,proto3" json:"iban,omitempty"`
+	// Set if the IBAN is invalid.
+	Error         *ValidationError `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_ibanpb_iban_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ibanpb_iban_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_ibanpb_iban_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ValidateResponse) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateResponse) GetIban() *IBAN {
+	if x != nil {
+		return x.Iban
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetError() *ValidationError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ParseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Iban          string                 `protobuf:"bytes,1,opt,name=iban,proto3" json:"iban,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseRequest) Reset() {
+	*x = ParseRequest{}
+	mi := &file_ibanpb_iban_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseRequest) ProtoMessage() {}
+
+func (x *ParseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ibanpb_iban_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseRequest.ProtoReflect.Descriptor instead.
+func (*ParseRequest) Descriptor() ([]byte, []int) {
+	return file_ibanpb_iban_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ParseRequest) GetIban() string {
+	if x != nil {
+		return x.Iban
+	}
+	return ""
+}
+
+type ParseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Iban          *IBAN                  `protobuf:"bytes,1,opt,name=iban,proto3" json:"iban,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseResponse) Reset() {
+	*x = ParseResponse{}
+	mi := &file_ibanpb_iban_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseResponse) ProtoMessage() {}
+
+func (x *ParseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ibanpb_iban_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseResponse.ProtoReflect.Descriptor instead.
+func (*ParseResponse) Descriptor() ([]byte, []int) {
+	return file_ibanpb_iban_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ParseResponse) GetIban() *IBAN {
+	if x != nil {
+		return x.Iban
+	}
+	return nil
+}
+
+type BuildRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	BankCode    string                 `protobuf:"bytes,2,opt,name=bank_code,json=bankCode,proto3" json:"bank_code,omitempty"`
+	Branch      string                 `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	Account     string                 `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	AccountType string                 `protobuf:"bytes,5,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	// Computed if empty and the country has national check digits.
+	NationalCheck string `protobuf:"bytes,6,opt,name=national_check,json=nationalCheck,proto3" json:"national_check,omitempty"`
+	// Other fields by their letter in the field layout of the country, e.g. "m" for the currency.
+	Other         map[string]string `protobuf:"bytes,7,rep,name=other,proto3" json:"other,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildRequest) Reset() {
+	*x = BuildRequest{}
+	mi := &file_ibanpb_iban_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildRequest) ProtoMessage() {}
+
+func (x *BuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ibanpb_iban_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildRequest.ProtoReflect.Descriptor instead.
+func (*BuildRequest) Descriptor() ([]byte, []int) {
+	return file_ibanpb_iban_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BuildRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *BuildRequest) GetBankCode() string {
+	if x != nil {
+		return x.BankCode
+	}
+	return ""
+}
+
+func (x *BuildRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *BuildRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *BuildRequest) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *BuildRequest) GetNationalCheck() string {
+	if x != nil {
+		return x.NationalCheck
+	}
+	return ""
+}
+
+func (x *BuildRequest) GetOther() map[string]string {
+	if x != nil {
+		return x.Other
+	}
+	return nil
+}
+
+type BuildResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Iban          *IBAN                  `protobuf:"bytes,1,opt,name=iban,proto3" json:"iban,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildResponse) Reset() {
+	*x = BuildResponse{}
+	mi := &file_ibanpb_iban_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildResponse) ProtoMessage() {}
+
+func (x *BuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ibanpb_iban_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildResponse.ProtoReflect.Descriptor instead.
+func (*BuildResponse) Descriptor() ([]byte, []int) {
+	return file_ibanpb_iban_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BuildResponse) GetIban() *IBAN {
+	if x != nil {
+		return x.Iban
+	}
+	return nil
+}
+
+var File_ibanpb_iban_proto protoreflect.FileDescriptor
+
+const file_ibanpb_iban_proto_rawDesc = "" +
+	"\n" +
+	"\x11ibanpb/iban.proto\x12\aiban.v1\"\xdf\x01\n" +
+	"\x0fValidationError\x12'\n" +
+	"\x06reason\x18\x01 \x01(\x0e2\x0f.iban.v1.ReasonR\x06reason\x12\x1f\n" +
+	"\vreason_name\x18\x02 \x01(\tR\n" +
+	"reasonName\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x12\x1a\n" +
+	"\bexpected\x18\x04 \x01(\tR\bexpected\x12\x16\n" +
+	"\x06actual\x18\x05 \x01(\tR\x06actual\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\"\xa5\x03\n" +
+	"\x04IBAN\x12\x1e\n" +
+	"\n" +
+	"electronic\x18\x01 \x01(\tR\n" +
+	"electronic\x12\x1c\n" +
+	"\tformatted\x18\x02 \x01(\tR\tformatted\x12!\n" +
+	"\fcountry_code\x18\x03 \x01(\tR\vcountryCode\x12!\n" +
+	"\fcheck_digits\x18\x04 \x01(\tR\vcheckDigits\x12\x12\n" +
+	"\x04bban\x18\x05 \x01(\tR\x04bban\x12\x1b\n" +
+	"\tbank_code\x18\x06 \x01(\tR\bbankCode\x12\x16\n" +
+	"\x06branch\x18\a \x01(\tR\x06branch\x12\x18\n" +
+	"\aaccount\x18\b \x01(\tR\aaccount\x12%\n" +
+	"\x0enational_check\x18\t \x01(\tR\rnationalCheck\x12!\n" +
+	"\faccount_type\x18\n" +
+	" \x01(\tR\vaccountType\x121\n" +
+	"\x06fields\x18\v \x03(\v2\x19.iban.v1.IBAN.FieldsEntryR\x06fields\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"%\n" +
+	"\x0fValidateRequest\x12\x12\n" +
+	"\x04iban\x18\x01 \x01(\tR\x04iban\"\x91\x01\n" +
+	"\x10ValidateResponse\x12\x14\n" +
+	"\x05input\x18\x01 \x01(\tR\x05input\x12\x14\n" +
+	"\x05valid\x18\x02 \x01(\bR\x05valid\x12!\n" +
+	"\x04iban\x18\x03 \x01(\v2\r.iban.v1.IBANR\x04iban\x12.\n" +
+	"\x05error\x18\x04 \x01(\v2\x18.iban.v1.ValidationErrorR\x05error\"\"\n" +
+	"\fParseRequest\x12\x12\n" +
+	"\x04iban\x18\x01 \x01(\tR\x04iban\"2\n" +
+	"\rParseResponse\x12!\n" +
+	"\x04iban\x18\x01 \x01(\v2\r.iban.v1.IBANR\x04iban\"\xbc\x02\n" +
+	"\fBuildRequest\x12!\n" +
+	"\fcountry_code\x18\x01 \x01(\tR\vcountryCode\x12\x1b\n" +
+	"\tbank_code\x18\x02 \x01(\tR\bbankCode\x12\x16\n" +
+	"\x06branch\x18\x03 \x01(\tR\x06branch\x12\x18\n" +
+	"\aaccount\x18\x04 \x01(\tR\aaccount\x12!\n" +
+	"\faccount_type\x18\x05 \x01(\tR\vaccountType\x12%\n" +
+	"\x0enational_check\x18\x06 \x01(\tR\rnationalCheck\x126\n" +
+	"\x05other\x18\a \x03(\v2 .iban.v1.BuildRequest.OtherEntryR\x05other\x1a8\n" +
+	"\n" +
+	"OtherEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"2\n" +
+	"\rBuildResponse\x12!\n" +
+	"\x04iban\x18\x01 \x01(\v2\r.iban.v1.IBANR\x04iban*\xbc\x03\n" +
+	"\x06Reason\x12\x16\n" +
+	"\x12REASON_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10REASON_TOO_SHORT\x10\x01\x12\x1a\n" +
+	"\x16REASON_UNKNOWN_COUNTRY\x10\x02\x12\x17\n" +
+	"\x13REASON_WRONG_LENGTH\x10\x03\x12\x17\n" +
+	"\x13REASON_BAD_CHECKSUM\x10\x04\x12\x1a\n" +
+	"\x16REASON_BAD_BBAN_FORMAT\x10\x05\x12#\n" +
+	"\x1fREASON_BAD_NATIONAL_CHECK_DIGIT\x10\x06\x12\x1c\n" +
+	"\x18REASON_ILLEGAL_CHARACTER\x10\a\x12\x1d\n" +
+	"\x19REASON_BAD_FIXED_CHECKSUM\x10\b\x12\x1e\n" +
+	"\x1aREASON_COUNTRY_NOT_ALLOWED\x10\t\x12\x13\n" +
+	"\x0fREASON_NOT_SEPA\x10\n" +
+	"\x12\"\n" +
+	"\x1eREASON_NATIONAL_CHECK_REQUIRED\x10\v\x12 \n" +
+	"\x1cREASON_NOT_ELECTRONIC_FORMAT\x10\f\x12\x19\n" +
+	"\x15REASON_RULE_VIOLATION\x10\r\x12\"\n" +
+	"\x1eREASON_BAD_ACCOUNT_CHECK_DIGIT\x10\x0e2\x88\x02\n" +
+	"\vIBANService\x12?\n" +
+	"\bValidate\x12\x18.iban.v1.ValidateRequest\x1a\x19.iban.v1.ValidateResponse\x126\n" +
+	"\x05Parse\x12\x15.iban.v1.ParseRequest\x1a\x16.iban.v1.ParseResponse\x126\n" +
+	"\x05Build\x12\x15.iban.v1.BuildRequest\x1a\x16.iban.v1.BuildResponse\x12H\n" +
+	"\rBatchValidate\x12\x18.iban.v1.ValidateRequest\x1a\x19.iban.v1.ValidateResponse(\x010\x01B\"Z github.com/go-pascal/iban/ibanpbb\x06proto3"
+
+var (
+	file_ibanpb_iban_proto_rawDescOnce sync.Once
+	file_ibanpb_iban_proto_rawDescData []byte
+)
+
+func file_ibanpb_iban_proto_rawDescGZIP() []byte {
+	file_ibanpb_iban_proto_rawDescOnce.Do(func() {
+		file_ibanpb_iban_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ibanpb_iban_proto_rawDesc), len(file_ibanpb_iban_proto_rawDesc)))
+	})
+	return file_ibanpb_iban_proto_rawDescData
+}
+
+var file_ibanpb_iban_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ibanpb_iban_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_ibanpb_iban_proto_goTypes = []any{
+	(Reason)(0),              // 0: iban.v1.Reason
+	(*ValidationError)(nil),  // 1: iban.v1.ValidationError
+	(*IBAN)(nil),             // 2: iban.v1.IBAN
+	(*ValidateRequest)(nil),  // 3: iban.v1.ValidateRequest
+	(*ValidateResponse)(nil), // 4: iban.v1.ValidateResponse
+	(*ParseRequest)(nil),     // 5: iban.v1.ParseRequest
+	(*ParseResponse)(nil),    // 6: iban.v1.ParseResponse
+	(*BuildRequest)(nil),     // 7: iban.v1.BuildRequest
+	(*BuildResponse)(nil),    // 8: iban.v1.BuildResponse
+	nil,                      // 9: iban.v1.IBAN.FieldsEntry
+	nil,                      // 10: iban.v1.BuildRequest.OtherEntry
+}
+var file_ibanpb_iban_proto_depIdxs = []int32{
+	0,  // 0: iban.v1.ValidationError.reason:type_name -> iban.v1.Reason
+	9,  // 1: iban.v1.IBAN.fields:type_name -> iban.v1.IBAN.FieldsEntry
+	2,  // 2: iban.v1.ValidateResponse.iban:type_name -> iban.v1.IBAN
+	1,  // 3: iban.v1.ValidateResponse.error:type_name -> iban.v1.ValidationError
+	2,  // 4: iban.v1.ParseResponse.iban:type_name -> iban.v1.IBAN
+	10, // 5: iban.v1.BuildRequest.other:type_name -> iban.v1.BuildRequest.OtherEntry
+	2,  // 6: iban.v1.BuildResponse.iban:type_name -> iban.v1.IBAN
+	3,  // 7: iban.v1.IBANService.Validate:input_type -> iban.v1.ValidateRequest
+	5,  // 8: iban.v1.IBANService.Parse:input_type -> iban.v1.ParseRequest
+	7,  // 9: iban.v1.IBANService.Build:input_type -> iban.v1.BuildRequest
+	3,  // 10: iban.v1.IBANService.BatchValidate:input_type -> iban.v1.ValidateRequest
+	4,  // 11: iban.v1.IBANService.Validate:output_type -> iban.v1.ValidateResponse
+	6,  // 12: iban.v1.IBANService.Parse:output_type -> iban.v1.ParseResponse
+	8,  // 13: iban.v1.IBANService.Build:output_type -> iban.v1.BuildResponse
+	4,  // 14: iban.v1.IBANService.BatchValidate:output_type -> iban.v1.ValidateResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_ibanpb_iban_proto_init() }
+func file_ibanpb_iban_proto_init() {
+	if File_ibanpb_iban_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ibanpb_iban_proto_rawDesc), len(file_ibanpb_iban_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ibanpb_iban_proto_goTypes,
+		DependencyIndexes: file_ibanpb_iban_proto_depIdxs,
+		EnumInfos:         file_ibanpb_iban_proto_enumTypes,
+		MessageInfos:      file_ibanpb_iban_proto_msgTypes,
+	}.Build()
+	File_ibanpb_iban_proto = out.File
+	file_ibanpb_iban_proto_goTypes = nil
+	file_ibanpb_iban_proto_depIdxs = nil
+}
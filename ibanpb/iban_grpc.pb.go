@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ibanpb/iban.proto
+
+package ibanpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	IBANService_Validate_FullMethodName      = "/iban.v1.IBANService/Validate"
+	IBANService_Parse_FullMethodName         = "/iban.v1.IBANService/Parse"
+	IBANService_Build_FullMethodName         = "/iban.v1.IBANService/Build"
+	IBANService_BatchValidate_FullMethodName = "/iban.v1.IBANService/BatchValidate"
+)
+
+// IBANServiceClient is the client API for IBANService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// IBANService validates, parses and builds IBANs.
+type IBANServiceClient interface {
+	// Validate checks an IBAN; an invalid IBAN is not an error but a response with valid set to false.
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	// Parse splits a valid IBAN into its parts. An invalid IBAN fails with INVALID_ARGUMENT and a
+	// ValidationError in the status details.
+	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	// Build creates a valid IBAN from national account details. Missing or too long details fail
+	// with INVALID_ARGUMENT.
+	Build(ctx context.Context, in *BuildRequest, opts ...grpc.CallOption) (*BuildResponse, error)
+	// BatchValidate validates a stream of IBANs and answers every request in order.
+	BatchValidate(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ValidateRequest, ValidateResponse], error)
+}
+
+type iBANServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIBANServiceClient(cc grpc.ClientConnInterface) IBANServiceClient {
+	return &iBANServiceClient{cc}
+}
+
+func (c *iBANServiceClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, IBANService_Validate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iBANServiceClient) Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseResponse)
+	err := c.cc.Invoke(ctx, IBANService_Parse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iBANServiceClient) Build(ctx context.Context, in *BuildRequest, opts ...grpc.CallOption) (*BuildResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuildResponse)
+	err := c.cc.Invoke(ctx, IBANService_Build_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iBANServiceClient) BatchValidate(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ValidateRequest, ValidateResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IBANService_ServiceDesc.Streams[0], IBANService_BatchValidate_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ValidateRequest, ValidateResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IBANService_BatchValidateClient = grpc.BidiStreamingClient[ValidateRequest, ValidateResponse]
+
+// IBANServiceServer is the server API for IBANService service.
+// All implementations must embed UnimplementedIBANServiceServer
+// for forward compatibility.
+//
+// IBANService validates, parses and builds IBANs.
+type IBANServiceServer interface {
+	// Validate checks an IBAN; an invalid IBAN is not an error but a response with valid set to false.
+	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	// Parse splits a valid IBAN into its parts. An invalid IBAN fails with INVALID_ARGUMENT and a
+	// ValidationError in the status details.
+	Parse(context.Context, *ParseRequest) (*ParseResponse, error)
+	// Build creates a valid IBAN from national account details. Missing or too long details fail
+	// with INVALID_ARGUMENT.
+	Build(context.Context, *BuildRequest) (*BuildResponse, error)
+	// BatchValidate validates a stream of IBANs and answers every request in order.
+	BatchValidate(grpc.BidiStreamingServer[ValidateRequest, ValidateResponse]) error
+	mustEmbedUnimplementedIBANServiceServer()
+}
+
+// UnimplementedIBANServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIBANServiceServer struct{}
+
+func (UnimplementedIBANServiceServer) Validate(context.Context, *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedIBANServiceServer) Parse(context.Context, *ParseRequest) (*ParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Parse not implemented")
+}
+func (UnimplementedIBANServiceServer) Build(context.Context, *BuildRequest) (*BuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Build not implemented")
+}
+func (UnimplementedIBANServiceServer) BatchValidate(grpc.BidiStreamingServer[ValidateRequest, ValidateResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method BatchValidate not implemented")
+}
+func (UnimplementedIBANServiceServer) mustEmbedUnimplementedIBANServiceServer() {}
+func (UnimplementedIBANServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeIBANServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IBANServiceServer will
+// result in compilation errors.
+type UnsafeIBANServiceServer interface {
+	mustEmbedUnimplementedIBANServiceServer()
+}
+
+func RegisterIBANServiceServer(s grpc.ServiceRegistrar, srv IBANServiceServer) {
+	// If the following call pancis, it indicates UnimplementedIBANServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&IBANService_ServiceDesc, srv)
+}
+
+func _IBANService_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IBANServiceServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IBANService_Validate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IBANServiceServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IBANService_Parse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IBANServiceServer).Parse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IBANService_Parse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IBANServiceServer).Parse(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IBANService_Build_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IBANServiceServer).Build(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IBANService_Build_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IBANServiceServer).Build(ctx, req.(*BuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IBANService_BatchValidate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IBANServiceServer).BatchValidate(&grpc.GenericServerStream[ValidateRequest, ValidateResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IBANService_BatchValidateServer = grpc.BidiStreamingServer[ValidateRequest, ValidateResponse]
+
+// IBANService_ServiceDesc is the grpc.ServiceDesc for IBANService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IBANService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iban.v1.IBANService",
+	HandlerType: (*IBANServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Validate",
+			Handler:    _IBANService_Validate_Handler,
+		},
+		{
+			MethodName: "Parse",
+			Handler:    _IBANService_Parse_Handler,
+		},
+		{
+			MethodName: "Build",
+			Handler:    _IBANService_Build_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BatchValidate",
+			Handler:       _IBANService_BatchValidate_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "ibanpb/iban.proto",
+}
@@ -0,0 +1,437 @@
+// Command gencountrylist generates countryList.go.
+//
+// The table is built from data/countries.csv, which holds what the SWIFT IBAN Registry
+// does not contain (the field layout letters, comments and fixed check digits) and the
+// countries that are not part of the registry. When a locally downloaded TXT or CSV export
+// of the registry is given, its names, lengths, BBAN formats, examples, bank and branch
+// identifier positions, SEPA membership and effective dates take precedence.
+//
+// Usage:
+//
+//	go run ./internal/cmd/gencountrylist -supplement data/countries.csv -registry data/swift_iban_registry.txt -out countryList.go
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// country is one entry of the generated table.
+type country struct {
+	code           string
+	name           string
+	length         int
+	bbanFormat     string
+	ibanFields     string
+	comment        string
+	fixedChecksum  string
+	example        string
+	bbanExample    string
+	bankPosition   [2]int
+	branchPosition [2]int
+	sepa           bool
+	effectiveDate  string
+}
+
+// registryEntry is the data of one country in the SWIFT IBAN Registry.
+type registryEntry struct {
+	code           string
+	name           string
+	length         int
+	bbanFormat     string
+	example        string
+	bbanExample    string
+	bankPosition   [2]int
+	branchPosition [2]int
+	sepa           bool
+	effectiveDate  string
+}
+
+func main() {
+	supplementPath := flag.String("supplement", "data/countries.csv", "the supplementary country table")
+	registryPath := flag.String("registry", "data/swift_iban_registry.txt", "the SWIFT IBAN Registry export (TXT or CSV), skipped if missing")
+	outPath := flag.String("out", "countryList.go", "the generated file")
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("gencountrylist: ")
+
+	supplementFile, err := os.Open(*supplementPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	supplement, err := readSupplement(supplementFile)
+	supplementFile.Close()
+	if err != nil {
+		log.Fatalf("%s: %v", *supplementPath, err)
+	}
+
+	var registry map[string]registryEntry
+	registryData, err := os.ReadFile(*registryPath)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		log.Printf("%s not found, generating from %s only", *registryPath, *supplementPath)
+	case err != nil:
+		log.Fatal(err)
+	default:
+		if registry, err = readRegistry(registryData); err != nil {
+			log.Fatalf("%s: %v", *registryPath, err)
+		}
+	}
+
+	countries, warnings, err := merge(supplement, registry)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, warning := range warnings {
+		log.Print(warning)
+	}
+
+	source, err := render(countries, registry != nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*outPath, source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readSupplement reads the supplementary country table.
+func readSupplement(r io.Reader) (map[string]*country, error) {
+	reader := csv.NewReader(r)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("empty file")
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[name] = i
+	}
+	for _, name := range []string{"code", "name", "length", "bban_format", "iban_fields", "comment", "fixed_checksum", "sepa", "example"} {
+		if _, exists := columns[name]; !exists {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+
+	countries := map[string]*country{}
+	for line, record := range records[1:] {
+		value := func(name string) string {
+			return strings.TrimSpace(record[columns[name]])
+		}
+
+		length, err := strconv.Atoi(value("length"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid length: %v", line+2, err)
+		}
+		c := &country{
+			code:          value("code"),
+			name:          value("name"),
+			length:        length,
+			bbanFormat:    value("bban_format"),
+			ibanFields:    value("iban_fields"),
+			comment:       value("comment"),
+			fixedChecksum: value("fixed_checksum"),
+			example:       value("example"),
+			sepa:          value("sepa") == "yes",
+		}
+		if _, exists := countries[c.code]; exists {
+			return nil, fmt.Errorf("line %d: duplicate country %s", line+2, c.code)
+		}
+		if !strings.HasPrefix(c.ibanFields, c.code) {
+			return nil, fmt.Errorf("line %d: iban_fields %q does not start with the country code %s", line+2, c.ibanFields, c.code)
+		}
+		countries[c.code] = c
+	}
+	return countries, nil
+}
+
+// readRegistry reads a TXT (tab separated) or CSV export of the SWIFT IBAN Registry.
+// The export has one row per data element and one column per country.
+func readRegistry(data []byte) (map[string]registryEntry, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(data) {
+		data = latin1ToUTF8(data)
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	if firstLine, _, _ := bytes.Cut(data, []byte("\n")); bytes.Contains(firstLine, []byte("\t")) {
+		reader.Comma = '\t'
+	}
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the values of every data element per column
+	elements := map[string][]string{}
+	columns := 0
+	for _, record := range records {
+		if len(record) == 0 {
+			continue
+		}
+		element := strings.ToLower(strings.Join(strings.Fields(record[0]), " "))
+		elements[element] = record[1:]
+		columns = max(columns, len(record)-1)
+	}
+
+	value := func(element string, column int) string {
+		values := elements[element]
+		if column >= len(values) {
+			return ""
+		}
+		return strings.TrimSpace(values[column])
+	}
+
+	if elements["iban prefix country code (iso 3166)"] == nil {
+		return nil, errors.New(`no "IBAN prefix country code (ISO 3166)" row, is this a SWIFT IBAN Registry export?`)
+	}
+
+	entries := map[string]registryEntry{}
+	for column := 0; column < columns; column++ {
+		code := value("iban prefix country code (iso 3166)", column)
+		if code == "" {
+			continue
+		}
+
+		length, err := strconv.Atoi(value("iban length", column))
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid IBAN length: %v", code, err)
+		}
+		bbanFormat, err := convertStructure(value("bban structure", column))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", code, err)
+		}
+		bankPosition, err := parsePosition(value("bank identifier position within the bban", column))
+		if err != nil {
+			return nil, fmt.Errorf("%s: bank identifier position: %v", code, err)
+		}
+		branchPosition, err := parsePosition(value("branch identifier position within the bban", column))
+		if err != nil {
+			return nil, fmt.Errorf("%s: branch identifier position: %v", code, err)
+		}
+
+		entries[code] = registryEntry{
+			code:           code,
+			name:           value("name of country", column),
+			length:         length,
+			bbanFormat:     bbanFormat,
+			example:        strings.ReplaceAll(value("iban electronic format example", column), " ", ""),
+			bbanExample:    strings.ReplaceAll(value("bban example", column), " ", ""),
+			bankPosition:   bankPosition,
+			branchPosition: branchPosition,
+			sepa:           strings.EqualFold(value("sepa country", column), "yes"),
+			effectiveDate:  value("effective date", column),
+		}
+	}
+	return entries, nil
+}
+
+// structurePattern matches one segment of a registry structure, e.g. "8!n".
+var structurePattern = regexp.MustCompile(`(\d+)!?([nac])`)
+
+// convertStructure converts a registry BBAN structure such as "4!a6!n8!c" to "4a,6n,8c".
+func convertStructure(structure string) (string, error) {
+	structure = strings.ReplaceAll(structure, " ", "")
+	matches := structurePattern.FindAllStringSubmatch(structure, -1)
+	var segments []string
+	consumed := 0
+	for _, match := range matches {
+		segments = append(segments, match[1]+match[2])
+		consumed += len(match[0])
+	}
+	if len(segments) == 0 || consumed != len(structure) {
+		return "", fmt.Errorf("unsupported BBAN structure %q", structure)
+	}
+	return strings.Join(segments, ","), nil
+}
+
+// parsePosition parses a registry position such as "1-4". "N/A" and empty values give [0, 0].
+func parsePosition(position string) ([2]int, error) {
+	if position == "" || strings.EqualFold(position, "N/A") {
+		return [2]int{}, nil
+	}
+	start, end, found := strings.Cut(position, "-")
+	if !found {
+		end = start
+	}
+	first, err := strconv.Atoi(strings.TrimSpace(start))
+	if err != nil {
+		return [2]int{}, err
+	}
+	last, err := strconv.Atoi(strings.TrimSpace(end))
+	if err != nil {
+		return [2]int{}, err
+	}
+	return [2]int{first, last}, nil
+}
+
+// merge combines the supplement with the registry and returns the countries sorted by code.
+// The warnings tell where the field layout of the supplement disagrees with the registry.
+func merge(supplement map[string]*country, registry map[string]registryEntry) ([]*country, []string, error) {
+	var warnings []string
+	countries := map[string]*country{}
+	for code, c := range supplement {
+		merged := *c
+		countries[code] = &merged
+	}
+
+	for code, entry := range registry {
+		c, exists := countries[code]
+		if !exists {
+			c = &country{code: code, ibanFields: layoutFromPositions(code, entry)}
+			c.comment = commentForLayout(c.ibanFields)
+			countries[code] = c
+			warnings = append(warnings, fmt.Sprintf("%s: not in the supplement, field layout derived from the registry", code))
+		}
+		c.name = entry.name
+		c.length = entry.length
+		c.bbanFormat = entry.bbanFormat
+		c.example = entry.example
+		c.bbanExample = entry.bbanExample
+		c.bankPosition = entry.bankPosition
+		c.branchPosition = entry.branchPosition
+		c.sepa = entry.sepa
+		c.effectiveDate = entry.effectiveDate
+
+		if bank := letterPosition(c.ibanFields, 'b'); entry.bankPosition != [2]int{} && bank != entry.bankPosition {
+			warnings = append(warnings, fmt.Sprintf("%s: bank code at %v in iban_fields, registry has %v", code, bank, entry.bankPosition))
+		}
+		if branch := letterPosition(c.ibanFields, 's'); entry.branchPosition != [2]int{} && branch != entry.branchPosition {
+			warnings = append(warnings, fmt.Sprintf("%s: branch code at %v in iban_fields, registry has %v", code, branch, entry.branchPosition))
+		}
+	}
+
+	var sorted []*country
+	for code, c := range countries {
+		if _, inRegistry := registry[code]; !inRegistry {
+			c.bankPosition = letterPosition(c.ibanFields, 'b')
+			c.branchPosition = letterPosition(c.ibanFields, 's')
+			if len(c.example) > 4 {
+				c.bbanExample = c.example[4:]
+			}
+		}
+		if length := len(strings.ReplaceAll(c.ibanFields, " ", "")); length != c.length {
+			return nil, nil, fmt.Errorf("%s: iban_fields %q has %d characters, but the IBAN length is %d", code, c.ibanFields, length, c.length)
+		}
+		sorted = append(sorted, c)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].code < sorted[j].code })
+	sort.Strings(warnings)
+	return sorted, warnings, nil
+}
+
+// letterPosition returns the first and last position (starting at 1) of a letter in the BBAN part of a field layout.
+func letterPosition(ibanFields string, letter byte) [2]int {
+	var position [2]int
+	bban := strings.ReplaceAll(ibanFields, " ", "")
+	for i := 4; i < len(bban); i++ {
+		if bban[i] == letter {
+			if position[0] == 0 {
+				position[0] = i - 3
+			}
+			position[1] = i - 3
+		}
+	}
+	return position
+}
+
+// layoutFromPositions builds a field layout such as "GBkk bbbb ssss sscc cccc cc" from the registry positions.
+func layoutFromPositions(code string, entry registryEntry) string {
+	letters := []byte(code + "kk" + strings.Repeat("c", entry.length-4))
+	for _, field := range []struct {
+		letter   byte
+		position [2]int
+	}{{'b', entry.bankPosition}, {'s', entry.branchPosition}} {
+		for i := field.position[0]; i > 0 && i <= field.position[1] && i+3 < len(letters); i++ {
+			letters[i+3] = field.letter
+		}
+	}
+
+	var builder strings.Builder
+	for i := 0; i < len(letters); i += 4 {
+		if i > 0 {
+			builder.WriteByte(' ')
+		}
+		builder.Write(letters[i:min(i+4, len(letters))])
+	}
+	return builder.String()
+}
+
+// commentForLayout describes the letters used in a field layout built by layoutFromPositions.
+func commentForLayout(ibanFields string) string {
+	var parts []string
+	for _, field := range []struct{ letter, meaning string }{
+		{"b", "Bank code"}, {"s", "Branch code"}, {"c", "Account number"},
+	} {
+		if strings.Contains(ibanFields[4:], field.letter) {
+			parts = append(parts, field.letter+" = "+field.meaning)
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// render returns the formatted source of countryList.go.
+func render(countries []*country, withRegistry bool) ([]byte, error) {
+	var buffer bytes.Buffer
+	sources := "data/countries.csv"
+	if withRegistry {
+		sources += " and the SWIFT IBAN Registry"
+	}
+	fmt.Fprintf(&buffer, "// Code generated by gencountrylist from %s; DO NOT EDIT.\n\n", sources)
+	buffer.WriteString("package iban\n\nvar countryList = map[string]ibanCountry{\n")
+	for _, c := range countries {
+		fmt.Fprintf(&buffer, "\t%q: {country: %q, chars: %d, bbanFormat: %q, code: %q, ibanFields: %q, comment: %q",
+			c.code, c.name, c.length, c.bbanFormat, c.code, c.ibanFields, c.comment)
+		if c.fixedChecksum != "" {
+			fmt.Fprintf(&buffer, ", fixedChecksum: %q", c.fixedChecksum)
+		}
+		if c.example != "" {
+			fmt.Fprintf(&buffer, ", example: %q", c.example)
+		}
+		if c.bbanExample != "" {
+			fmt.Fprintf(&buffer, ", bbanExample: %q", c.bbanExample)
+		}
+		if c.bankPosition != [2]int{} {
+			fmt.Fprintf(&buffer, ", bankPosition: [2]int{%d, %d}", c.bankPosition[0], c.bankPosition[1])
+		}
+		if c.branchPosition != [2]int{} {
+			fmt.Fprintf(&buffer, ", branchPosition: [2]int{%d, %d}", c.branchPosition[0], c.branchPosition[1])
+		}
+		if c.sepa {
+			buffer.WriteString(", sepa: true")
+		}
+		if c.effectiveDate != "" {
+			fmt.Fprintf(&buffer, ", effectiveDate: %q", c.effectiveDate)
+		}
+		buffer.WriteString("},\n")
+	}
+	buffer.WriteString("}\n")
+	return format.Source(buffer.Bytes())
+}
+
+// latin1ToUTF8 converts ISO 8859-1 text, in which older registry exports are encoded, to UTF-8.
+func latin1ToUTF8(data []byte) []byte {
+	var buffer bytes.Buffer
+	buffer.Grow(len(data) + len(data)/8)
+	for _, b := range data {
+		buffer.WriteRune(rune(b))
+	}
+	return buffer.Bytes()
+}
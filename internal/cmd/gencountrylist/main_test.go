@@ -0,0 +1,86 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+const testSupplement = `code,name,length,bban_format,iban_fields,comment,fixed_checksum,sepa,example
+AD,Andorra,24,"8n,12c",ADkk bbbb ssss cccc cccc cccc,b = National bank code s = Branch code c = Account number,,yes,AD1400080001001234567890
+GB,United Kingdom,22,"4a,14n",GBkk bbbb ssss sscc cccc cc,b = BIC bank code s = Sort code c = Account number,,yes,GB98MIDL07009312345678
+BJ,Benin,28,"2c,22n",BJkk bbbb ssss cccc cccc cccc cccc,b = Bank code; s = Branch code; c = Account number,,no,BJ66BJ0610100100144390000769
+`
+
+func TestConvertStructure(t *testing.T) {
+	for structure, expected := range map[string]string{
+		"4!a6!n8!c": "4a,6n,8c",
+		"16!n":      "16n",
+		"2!n 3!c":   "2n,3c",
+	} {
+		if actual, err := convertStructure(structure); err != nil || actual != expected {
+			t.Errorf("%s: got %q (%v), expected %q", structure, actual, err, expected)
+		}
+	}
+	for _, structure := range []string{"", "4!x", "4!a-6!n"} {
+		if _, err := convertStructure(structure); err == nil {
+			t.Errorf("%s: expected an error", structure)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	supplement, err := readSupplement(strings.NewReader(testSupplement))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile("testdata/registry.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry, err := readRegistry(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(registry) != 3 || registry["GB"].bbanFormat != "4a,6n,8n" || registry["LY"].branchPosition != [2]int{4, 6} {
+		t.Fatalf("got %+v", registry)
+	}
+
+	countries, warnings, err := merge(supplement, registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 1 || !strings.HasPrefix(warnings[0], "LY:") {
+		t.Errorf("got warnings %v", warnings)
+	}
+
+	source, err := render(countries, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"// Code generated by gencountrylist from data/countries.csv and the SWIFT IBAN Registry; DO NOT EDIT.",
+		`"AD": {country: "Andorra", chars: 24, bbanFormat: "4n,4n,12c", code: "AD", ibanFields: "ADkk bbbb ssss cccc cccc cccc", comment: "b = National bank code s = Branch code c = Account number", example: "AD1200012030200359100100", bbanExample: "00012030200359100100", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}, sepa: true, effectiveDate: "Apr-07"},`,
+		`"BJ": {country: "Benin", chars: 28, bbanFormat: "2c,22n", code: "BJ", ibanFields: "BJkk bbbb ssss cccc cccc cccc cccc", comment: "b = Bank code; s = Branch code; c = Account number", example: "BJ66BJ0610100100144390000769", bbanExample: "BJ0610100100144390000769", bankPosition: [2]int{1, 4}, branchPosition: [2]int{5, 8}},`,
+		`"LY": {country: "Libya", chars: 25, bbanFormat: "3n,3n,15n", code: "LY", ibanFields: "LYkk bbbs sscc cccc cccc cccc c", comment: "b = Bank code; s = Branch code; c = Account number",`,
+	} {
+		if !strings.Contains(string(source), expected) {
+			t.Errorf("generated source does not contain\n%s\n\n%s", expected, source)
+		}
+	}
+
+	again, _ := render(countries, true)
+	if string(again) != string(source) {
+		t.Error("the output is not deterministic")
+	}
+}
+
+func TestMergeRejectsWrongLayout(t *testing.T) {
+	supplement, err := readSupplement(strings.NewReader(strings.Replace(testSupplement, "GB,United Kingdom,22", "GB,United Kingdom,23", 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := merge(supplement, nil); err == nil {
+		t.Error("expected an error for a layout that does not match the length")
+	}
+}
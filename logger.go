@@ -0,0 +1,59 @@
+package iban
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync/atomic"
+)
+
+// logger receives the validation events; nil (the default) means the package is silent.
+var logger atomic.Pointer[slog.Logger]
+
+// SetLogger sets the logger that receives the validation events of IsCorrectIban, NewIBAN
+// and GetIbanChecksum. Passing nil makes the package silent again, which is the default.
+//
+// Rejected IBANs are logged at debug level, or at info level when IsCorrectIban is called
+// with debug set to true; in that case accepted IBANs are logged as well.
+// The events carry the country, the reason and the masked IBAN as attributes.
+func SetLogger(l *slog.Logger) {
+	logger.Store(l)
+}
+
+// logResult logs the result of a validation.
+func logResult(debug bool, iban string, err error) {
+	logEvent(logger.Load(), debug, iban, err)
+}
+
+// logEvent logs the result of a validation to the given logger, if any.
+func logEvent(l *slog.Logger, debug bool, iban string, err error) {
+	if l == nil || (err == nil && !debug) {
+		return
+	}
+
+	level := slog.LevelDebug
+	if debug {
+		level = slog.LevelInfo
+	}
+	ctx := context.Background()
+	if !l.Enabled(ctx, level) {
+		return
+	}
+
+	normalized, _ := Normalize(iban)
+	masked := obscureIban(normalized)
+	if err == nil {
+		l.LogAttrs(ctx, level, "IBAN accepted", slog.String("country", masked[:2]), slog.String("iban", masked))
+		return
+	}
+
+	attrs := []slog.Attr{slog.String("iban", masked), slog.String("error", err.Error())}
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		attrs = append(attrs, slog.String("country", validationErr.Country), slog.String("reason", validationErr.Reason.String()))
+		if validationErr.Position >= 0 {
+			attrs = append(attrs, slog.Int("position", validationErr.Position))
+		}
+	}
+	l.LogAttrs(ctx, level, "IBAN rejected", attrs...)
+}
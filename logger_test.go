@@ -0,0 +1,62 @@
+package iban
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// captureEvents sets a JSON logger at the given level and returns the decoded events after calling f.
+func captureEvents(t *testing.T, level slog.Level, f func()) []map[string]any {
+	t.Helper()
+	var buffer bytes.Buffer
+	SetLogger(slog.New(slog.NewJSONHandler(&buffer, &slog.HandlerOptions{Level: level})))
+	defer SetLogger(nil)
+	f()
+
+	var events []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var event map[string]any
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestLoggerEvents(t *testing.T) {
+	events := captureEvents(t, slog.LevelDebug, func() {
+		IsCorrectIban("GB83 WEST 1234 5698 7654 32", false)
+		IsCorrectIban("GB82 WEST 1234 5698 7654 32", false)
+		IsCorrectIban("GB82 WEST 1234 5698 7654 32", true)
+	})
+	if len(events) != 2 {
+		t.Fatalf("got %d events, expected 2: %v", len(events), events)
+	}
+
+	rejected := events[0]
+	if rejected["level"] != "DEBUG" || rejected["msg"] != "IBAN rejected" || rejected["reason"] != "BadChecksum" ||
+		rejected["country"] != "GB" || rejected["iban"] != "GB****************5432" {
+		t.Errorf("got %v", rejected)
+	}
+	accepted := events[1]
+	if accepted["level"] != "INFO" || accepted["msg"] != "IBAN accepted" || accepted["country"] != "GB" {
+		t.Errorf("got %v", accepted)
+	}
+}
+
+func TestLoggerLevel(t *testing.T) {
+	events := captureEvents(t, slog.LevelInfo, func() {
+		NewIBAN("GB83 WEST 1234 5698 7654 32")
+		IsCorrectIban("GB83 WEST 1234 5698 7654 32", true)
+	})
+	if len(events) != 1 || events[0]["level"] != "INFO" {
+		t.Errorf("expected only the debug call to be logged at info level, got %v", events)
+	}
+}
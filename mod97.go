@@ -0,0 +1,25 @@
+package iban
+
+// calculateModulo calculates the modulo 97 of an IBAN rearranged for validation:
+// the BBAN followed by the country code and the check digits. The parts are read in one pass
+// without building the rearranged string, and letters are folded to their two digit values
+// (A = 10, ..., Z = 35) on the fly. Characters other than 0-9 and A-Z are rejected with
+// an IllegalCharacter error giving their position in the IBAN.
+func calculateModulo(countryCode, checksum, bban string) (int, error) {
+	rest := 0
+	parts := [3]string{bban, countryCode, checksum}
+	offsets := [3]int{len(countryCode) + len(checksum), 0, len(countryCode)}
+	for p, part := range parts {
+		for i := 0; i < len(part); i++ {
+			switch char := part[i]; {
+			case char >= '0' && char <= '9':
+				rest = (rest*10 + int(char-'0')) % 97
+			case char >= 'A' && char <= 'Z':
+				rest = (rest*100 + int(char-'A'+10)) % 97
+			default:
+				return -1, &ValidationError{Reason: IllegalCharacter, Actual: string(char), Position: offsets[p] + i}
+			}
+		}
+	}
+	return rest, nil
+}
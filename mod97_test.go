@@ -0,0 +1,74 @@
+package iban
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// referenceModulo calculates the IBAN modulo 97 the textbook way, with a big integer.
+func referenceModulo(iban string) int {
+	var digits strings.Builder
+	for _, char := range iban[4:] + iban[:4] {
+		if char >= 'A' && char <= 'Z' {
+			digits.WriteString(strconv.Itoa(int(char - 'A' + 10)))
+		} else {
+			digits.WriteRune(char)
+		}
+	}
+	value, _ := new(big.Int).SetString(digits.String(), 10)
+	return int(new(big.Int).Mod(value, big.NewInt(97)).Int64())
+}
+
+func TestCalculateModulo(t *testing.T) {
+	numbers := []string{"GB82WEST12345698765432", "GB00WEST12345698765432", "ZZ99ZZZZZZZZZZZZZZZZZZZZZZZZZZZZ", "AA000000000000000"}
+	for _, spec := range Countries() {
+		numbers = append(numbers, spec.Example)
+	}
+
+	for _, number := range numbers {
+		rest, err := calculateModulo(number[:2], number[2:4], number[4:])
+		if err != nil {
+			t.Errorf("%s: %v", number, err)
+		}
+		if expected := referenceModulo(number); rest != expected {
+			t.Errorf("%s: got %d, expected %d", number, rest, expected)
+		}
+	}
+}
+
+func TestCalculateModuloIllegalCharacter(t *testing.T) {
+	for number, position := range map[string]int{
+		"GB82WEST1234569876543-": 21,
+		"GB8.WEST12345698765432": 3,
+		"gB82WEST12345698765432": 0,
+	} {
+		rest, err := calculateModulo(number[:2], number[2:4], number[4:])
+		var validationErr *ValidationError
+		if rest != -1 || !errors.As(err, &validationErr) || validationErr.Reason != IllegalCharacter || validationErr.Position != position {
+			t.Errorf("%s: got %d, %v", number, rest, err)
+		}
+	}
+
+	if _, err := GetIbanChecksum("GB00 WEST 1234 5698 7654 3!"); !errors.Is(err, &ValidationError{Reason: IllegalCharacter}) {
+		t.Errorf("expected IllegalCharacter, got %v", err)
+	}
+}
+
+func TestCalculateModuloAllocations(t *testing.T) {
+	allocations := testing.AllocsPerRun(100, func() {
+		calculateModulo("GB", "82", "WEST12345698765432")
+	})
+	if allocations != 0 {
+		t.Errorf("got %v allocations, expected none", allocations)
+	}
+}
+
+func BenchmarkCalculateModulo(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		calculateModulo("MT", "84", "MALT011000012345MTLCAST001S")
+	}
+}
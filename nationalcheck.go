@@ -0,0 +1,260 @@
+package iban
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+)
+
+// errNotNumeric is returned by a national check when the BBAN contains
+// characters the algorithm cannot handle.
+var errNotNumeric = errors.New("IBAN: national check digits can only be computed over digits")
+
+// errNoCheckDigit is returned by a national check when no check digit exists for the account number.
+var errNoCheckDigit = errors.New("IBAN: no national check digit exists for this account number")
+
+// NationalCheck describes how the national check digits of a country's BBAN are verified.
+type NationalCheck struct {
+	Offset  int                               // The index (starting at 0) of the check digits in the BBAN
+	Length  int                               // The number of check digits
+	Compute func(bban string) (string, error) // Computes the check digits the BBAN should contain
+}
+
+var (
+	nationalChecksMutex sync.RWMutex
+	nationalChecks      = map[string]NationalCheck{
+		"BE": {Offset: 10, Length: 2, Compute: belgianCheck},
+		"ES": {Offset: 8, Length: 2, Compute: spanishCheck},
+		"FI": {Offset: 13, Length: 1, Compute: finnishCheck},
+		"FR": {Offset: 21, Length: 2, Compute: ribCheck},
+		"IT": {Offset: 0, Length: 1, Compute: italianCheck},
+		"NO": {Offset: 10, Length: 1, Compute: norwegianCheck},
+		"PT": {Offset: 19, Length: 2, Compute: portugueseCheck},
+
+		// The French territories and Monaco use the French RIB key,
+		// San Marino the Italian CIN.
+		"BL": {Offset: 21, Length: 2, Compute: ribCheck},
+		"MC": {Offset: 21, Length: 2, Compute: ribCheck},
+		"MF": {Offset: 21, Length: 2, Compute: ribCheck},
+		"MR": {Offset: 21, Length: 2, Compute: ribCheck},
+		"RE": {Offset: 21, Length: 2, Compute: ribCheck},
+		"YT": {Offset: 21, Length: 2, Compute: ribCheck},
+		"SM": {Offset: 0, Length: 1, Compute: italianCheck},
+	}
+)
+
+// RegisterNationalCheck adds or replaces the national check of a country.
+// It is run by IsCorrectIban and NewIBAN after the IBAN checksum was verified.
+func RegisterNationalCheck(countryCode string, check NationalCheck) {
+	nationalChecksMutex.Lock()
+	defer nationalChecksMutex.Unlock()
+	nationalChecks[countryCode] = check
+}
+
+// UnregisterNationalCheck removes the national check of a country.
+func UnregisterNationalCheck(countryCode string) {
+	nationalChecksMutex.Lock()
+	defer nationalChecksMutex.Unlock()
+	delete(nationalChecks, countryCode)
+}
+
+// lookupNationalCheck returns the national check registered for a country.
+func lookupNationalCheck(countryCode string) (NationalCheck, bool) {
+	nationalChecksMutex.RLock()
+	defer nationalChecksMutex.RUnlock()
+	check, exists := nationalChecks[countryCode]
+	return check, exists
+}
+
+// checkNationalDigits verifies the national check digits of the BBAN, if a check is registered for the country.
+func checkNationalDigits(countryCode, bban string) error {
+	check, exists := lookupNationalCheck(countryCode)
+	if !exists || check.Offset+check.Length > len(bban) {
+		return nil
+	}
+
+	actual := bban[check.Offset : check.Offset+check.Length]
+	expected, err := check.Compute(bban)
+	if err != nil || expected != actual {
+		return &ValidationError{
+			Reason:   BadNationalCheckDigit,
+			Country:  countryCode,
+			Expected: expected,
+			Actual:   actual,
+			Position: check.Offset + 4,
+			Err:      err,
+		}
+	}
+	return nil
+}
+
+// digitsModulo returns the value of a string of digits modulo m.
+func digitsModulo(digits string, m int) (int, error) {
+	rest := 0
+	for i := 0; i < len(digits); i++ {
+		if digits[i] < '0' || digits[i] > '9' {
+			return 0, errNotNumeric
+		}
+		rest = (rest*10 + int(digits[i]-'0')) % m
+	}
+	return rest, nil
+}
+
+// weightedSum returns the sum of the digits multiplied by their weights.
+func weightedSum(digits string, weights []int) (int, error) {
+	sum := 0
+	for i := 0; i < len(digits) && i < len(weights); i++ {
+		if digits[i] < '0' || digits[i] > '9' {
+			return 0, errNotNumeric
+		}
+		sum += int(digits[i]-'0') * weights[i]
+	}
+	return sum, nil
+}
+
+// belgianCheck computes the Belgian check digits: the bank and account number modulo 97, 97 instead of 0.
+func belgianCheck(bban string) (string, error) {
+	rest, err := digitsModulo(bban[:10], 97)
+	if err != nil {
+		return "", err
+	}
+	if rest == 0 {
+		rest = 97
+	}
+	return twoDigits(rest), nil
+}
+
+// ribCheck computes the French RIB key over the bank code, branch code and account number.
+// Letters in the account number are replaced by digits: A-I by 1-9, J-R by 1-9 and S-Z by 2-9.
+func ribCheck(bban string) (string, error) {
+	account := []byte(bban[10:21])
+	for i, char := range account {
+		switch {
+		case char >= 'A' && char <= 'I':
+			account[i] = '1' + char - 'A'
+		case char >= 'J' && char <= 'R':
+			account[i] = '1' + char - 'J'
+		case char >= 'S' && char <= 'Z':
+			account[i] = '2' + char - 'S'
+		}
+	}
+
+	bank, err := digitsModulo(bban[:5], 97)
+	if err != nil {
+		return "", err
+	}
+	branch, err := digitsModulo(bban[5:10], 97)
+	if err != nil {
+		return "", err
+	}
+	accountRest, err := digitsModulo(string(account), 97)
+	if err != nil {
+		return "", err
+	}
+	return twoDigits(97 - (89*bank+15*branch+3*accountRest)%97), nil
+}
+
+// spanishCheck computes the two Spanish control digits (dígitos de control):
+// the first over the bank and branch code, the second over the account number.
+func spanishCheck(bban string) (string, error) {
+	control := func(digits string, weights []int) (string, error) {
+		sum, err := weightedSum(digits, weights)
+		if err != nil {
+			return "", err
+		}
+		switch digit := 11 - sum%11; digit {
+		case 11:
+			return "0", nil
+		case 10:
+			return "1", nil
+		default:
+			return strconv.Itoa(digit), nil
+		}
+	}
+
+	first, err := control(bban[:8], []int{4, 8, 5, 10, 9, 7, 3, 6})
+	if err != nil {
+		return "", err
+	}
+	second, err := control(bban[10:20], []int{1, 2, 4, 8, 5, 10, 9, 7, 3, 6})
+	if err != nil {
+		return "", err
+	}
+	return first + second, nil
+}
+
+// cinOddValues are the values of the characters at odd positions for the Italian CIN,
+// indexed by the digit value or the letter's position in the alphabet.
+var cinOddValues = []int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23}
+
+// italianCheck computes the Italian CIN letter over the ABI, CAB and account number.
+func italianCheck(bban string) (string, error) {
+	sum := 0
+	for i := 1; i < len(bban); i++ {
+		var value int
+		switch char := bban[i]; {
+		case char >= '0' && char <= '9':
+			value = int(char - '0')
+		case char >= 'A' && char <= 'Z':
+			value = int(char - 'A')
+		default:
+			return "", errNotNumeric
+		}
+
+		if i%2 == 1 {
+			value = cinOddValues[value]
+		}
+		sum += value
+	}
+	return string(rune('A' + sum%26)), nil
+}
+
+// portugueseCheck computes the Portuguese NIB check digits: 98 minus the NIB with "00" appended modulo 97.
+func portugueseCheck(bban string) (string, error) {
+	rest, err := digitsModulo(bban[:19]+"00", 97)
+	if err != nil {
+		return "", err
+	}
+	return twoDigits(98 - rest), nil
+}
+
+// norwegianCheck computes the Norwegian modulo 11 check digit.
+// Account numbers for which the check digit would be 10 are not issued.
+func norwegianCheck(bban string) (string, error) {
+	sum, err := weightedSum(bban[:10], []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2})
+	if err != nil {
+		return "", err
+	}
+	switch digit := 11 - sum%11; digit {
+	case 11:
+		return "0", nil
+	case 10:
+		return "", errNoCheckDigit
+	default:
+		return strconv.Itoa(digit), nil
+	}
+}
+
+// finnishCheck computes the Finnish Luhn check digit over the bank and account number.
+func finnishCheck(bban string) (string, error) {
+	sum := 0
+	for i := 12; i >= 0; i-- {
+		if bban[i] < '0' || bban[i] > '9' {
+			return "", errNotNumeric
+		}
+		value := int(bban[i] - '0')
+		if (12-i)%2 == 0 {
+			value *= 2
+		}
+		sum += value/10 + value%10
+	}
+	return strconv.Itoa((10 - sum%10) % 10), nil
+}
+
+// twoDigits formats a number below 100 as two digits.
+func twoDigits(value int) string {
+	if value < 10 {
+		return "0" + strconv.Itoa(value)
+	}
+	return strconv.Itoa(value)
+}
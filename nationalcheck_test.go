@@ -0,0 +1,81 @@
+package iban
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+var nationalCheckTestNumbers = []struct {
+	valid    string
+	invalid  string // The valid BBAN with a wrong national check, with recomputed IBAN check digits
+	expected string
+}{
+	{"BE68539007547034", "BE..539007547035", "34"},
+	{"FR1420041010050500013M02606", "FR..20041010050500013M02607", "06"},
+	{"MC5810096180790123456789085", "MC..10096180790123456789086", "85"},
+	{"ES9121000418450200051332", "ES..21000418460200051332", "45"},
+	{"IT60X0542811101000000123456", "IT..Y0542811101000000123456", "X"},
+	{"SM76P0854009812123456789123", "SM..Q0854009812123456789123", "P"},
+	{"NO9386011117947", "NO..86011117948", "7"},
+	{"FI2112345600000785", "FI..12345600000786", "5"},
+}
+
+// withChecksum replaces the ".." placeholder of the IBAN by the correct IBAN check digits.
+func withChecksum(t *testing.T, iban string) string {
+	t.Helper()
+	checksum, err := GetIbanChecksum(iban[:2] + "00" + iban[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf("%s%02d%s", iban[:2], checksum, iban[4:])
+}
+
+func TestNationalCheck(t *testing.T) {
+	for _, test := range nationalCheckTestNumbers {
+		if _, err := NewIBAN(test.valid); err != nil {
+			t.Errorf("%s: %v", test.valid, err)
+		}
+
+		invalid := withChecksum(t, test.invalid)
+		_, err := NewIBAN(invalid)
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Reason != BadNationalCheckDigit {
+			t.Errorf("%s: expected BadNationalCheckDigit, got %v", invalid, err)
+			continue
+		}
+		if validationErr.Expected != test.expected {
+			t.Errorf("%s: expected national check digits %s, got %s", invalid, test.expected, validationErr.Expected)
+		}
+	}
+}
+
+// With its fixed IBAN check digits a Portuguese IBAN with a wrong NIB already fails
+// the checksum, so the algorithm is tested on its own.
+func TestPortugueseCheck(t *testing.T) {
+	for bban, expected := range map[string]string{
+		"002700000001234567833": "33",
+		"000200000001234567813": "13",
+		"003506510000000000061": "61",
+	} {
+		if actual, err := portugueseCheck(bban); err != nil || actual != expected {
+			t.Errorf("%s: got %s (%v), expected %s", bban, actual, err, expected)
+		}
+	}
+}
+
+func TestRegisterNationalCheck(t *testing.T) {
+	const number = "GB82 WEST 1234 5698 7654 32"
+	RegisterNationalCheck("GB", NationalCheck{Offset: 17, Length: 1, Compute: func(string) (string, error) {
+		return "0", nil
+	}})
+	_, err := NewIBAN(number)
+	UnregisterNationalCheck("GB")
+
+	if !errors.Is(err, &ValidationError{Reason: BadNationalCheckDigit}) {
+		t.Errorf("expected BadNationalCheckDigit, got %v", err)
+	}
+	if _, err := NewIBAN(number); err != nil {
+		t.Errorf("unexpected error after unregistering the check: %v", err)
+	}
+}
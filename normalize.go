@@ -0,0 +1,92 @@
+package iban
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Changes reports what Normalize changed in its input.
+type Changes struct {
+	Removed     bool // Separators, invisible characters or the "IBAN" prefix were removed
+	Transformed bool // Characters were mapped to upper case ASCII, e.g. lower case or full-width characters
+}
+
+// Changed reports whether the input was not in the electronic format.
+func (c Changes) Changed() bool {
+	return c.Removed || c.Transformed
+}
+
+// Normalize converts an IBAN as it is entered or pasted into the electronic format.
+// IsCorrectIban, NewIBAN and GetIbanChecksum normalize their input with it. The rules are:
+//
+//   - white space is removed, including tabs, line breaks and non-breaking spaces;
+//   - hyphens, dashes and dots are removed;
+//   - zero-width characters, the soft hyphen and the byte order mark are removed;
+//   - full-width digits and letters are mapped to ASCII and letters to upper case;
+//   - a leading "IBAN" or "IBAN:" prefix, in any case, is removed.
+//
+// Other characters are kept, so the validation reports them as illegal characters.
+// Strict callers can reject the input if the returned Changes are not empty.
+func Normalize(input string) (string, Changes) {
+	var changes Changes
+	if isElectronic(input) {
+		return input, changes
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(input))
+	for i := 0; i < len(input); {
+		r, size := utf8.DecodeRuneInString(input[i:])
+		mapped, keep := normalizeRune(r)
+		switch {
+		case !keep:
+			changes.Removed = true
+		case mapped != r:
+			changes.Transformed = true
+			builder.WriteRune(mapped)
+		default:
+			// Write the original bytes, so invalid UTF-8 is reported as it is
+			builder.WriteString(input[i : i+size])
+		}
+		i += size
+	}
+
+	normalized := builder.String()
+	if strings.HasPrefix(normalized, "IBAN") {
+		normalized = strings.TrimPrefix(normalized[4:], ":")
+		changes.Removed = true
+	}
+	return normalized, changes
+}
+
+// normalizeRune maps a character to its electronic format; keep is false if it is removed.
+func normalizeRune(r rune) (mapped rune, keep bool) {
+	switch {
+	case r >= 'a' && r <= 'z':
+		return r - 'a' + 'A', true
+	case r >= '\uFF10' && r <= '\uFF19': // Full-width digits
+		return r - '\uFF10' + '0', true
+	case r >= '\uFF21' && r <= '\uFF3A': // Full-width upper case letters
+		return r - '\uFF21' + 'A', true
+	case r >= '\uFF41' && r <= '\uFF5A': // Full-width lower case letters
+		return r - '\uFF41' + 'A', true
+	case unicode.IsSpace(r), r == '-', r == '.':
+		return r, false
+	case r >= '\u2010' && r <= '\u2015', r == '\u2212', r == '\uFF0D', r == '\uFF0E': // Dashes, the minus sign and full-width hyphen and dot
+		return r, false
+	case r == '\u00AD', r >= '\u200B' && r <= '\u200D', r == '\u2060', r == '\uFEFF': // Soft hyphen, zero-width characters and byte order mark
+		return r, false
+	}
+	return r, true
+}
+
+// isElectronic reports whether the input contains upper case ASCII letters and digits only.
+func isElectronic(input string) bool {
+	for i := 0; i < len(input); i++ {
+		if !matchesClass(input[i], classAlphaNumeric) {
+			return false
+		}
+	}
+	return !strings.HasPrefix(input, "IBAN")
+}
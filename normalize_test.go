@@ -0,0 +1,58 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+var normalizeTests = []struct {
+	input       string
+	expected    string
+	removed     bool
+	transformed bool
+}{
+	{"GB82WEST12345698765432", "GB82WEST12345698765432", false, false},
+	{"GB82 WEST 1234 5698 7654 32", "GB82WEST12345698765432", true, false},
+	{"gb82 west 1234 5698 7654 32", "GB82WEST12345698765432", true, true},
+	{"GB82\u00A0WEST\t1234\n5698\u202F7654 32", "GB82WEST12345698765432", true, false},
+	{"GB82-WEST-1234.5698\u20137654\u221232", "GB82WEST12345698765432", true, false},
+	{"\uFEFFGB82\u200BWEST\u00AD12345698\u200D765432", "GB82WEST12345698765432", true, false},
+	{"ＧＢ８２ＷＥＳＴ１２３４５６９８７６５４３２", "GB82WEST12345698765432", false, true},
+	{"IBAN: GB82 WEST 1234 5698 7654 32", "GB82WEST12345698765432", true, false},
+	{"iban GB82WEST12345698765432", "GB82WEST12345698765432", true, true},
+	{"IBANGB82WEST12345698765432", "GB82WEST12345698765432", true, false},
+	{"GB82/WEST", "GB82/WEST", false, false},
+}
+
+func TestNormalize(t *testing.T) {
+	for _, test := range normalizeTests {
+		normalized, changes := Normalize(test.input)
+		if normalized != test.expected || changes.Removed != test.removed || changes.Transformed != test.transformed {
+			t.Errorf("%q: got %q %+v, expected %q removed=%v transformed=%v",
+				test.input, normalized, changes, test.expected, test.removed, test.transformed)
+		}
+	}
+}
+
+func TestNormalizedIbanIsAccepted(t *testing.T) {
+	for _, test := range normalizeTests {
+		if test.expected != "GB82WEST12345698765432" {
+			continue
+		}
+		if ok, _, err := IsCorrectIban(test.input, false); !ok {
+			t.Errorf("%q: expected the IBAN to be accepted, got %v", test.input, err)
+		}
+		if checksum, err := GetIbanChecksum(test.input); err != nil || checksum != 82 {
+			t.Errorf("%q: expected checksum 82, got %d %v", test.input, checksum, err)
+		}
+
+		_, err := NewValidator(WithStrictFormat()).Validate(test.input)
+		if test.removed || test.transformed {
+			if !errors.Is(err, &ValidationError{Reason: NotElectronicFormat}) {
+				t.Errorf("%q: expected the strict validator to reject the IBAN, got %v", test.input, err)
+			}
+		} else if err != nil {
+			t.Errorf("%q: expected the strict validator to accept the IBAN, got %v", test.input, err)
+		}
+	}
+}
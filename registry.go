@@ -0,0 +1,111 @@
+package iban
+
+import (
+	"sort"
+	"strings"
+)
+
+// CountrySpec describes the IBAN rules of a country.
+// It is a copy of the internal country list, so changing it has no effect on the validation.
+type CountrySpec struct {
+	Code             string // The country code, e.g. "GB"
+	Name             string // The country name
+	Length           int    // The length of the IBAN
+	BBANFormat       string // The format of the BBAN, e.g. "4a,14n"
+	IBANFields       string // The field layout of the IBAN, e.g. "GBkk bbbb ssss sscc cccc cc"
+	Comment          string // The meaning of the letters used in the field layout
+	Example          string // An example IBAN in electronic format
+	BBANExample      string // An example BBAN
+	BankPosition     [2]int // The first and last position (starting at 1) of the bank identifier in the BBAN
+	BranchPosition   [2]int // The first and last position (starting at 1) of the branch identifier in the BBAN, zero if none
+	SEPA             bool   // Whether the country is part of the Single Euro Payments Area
+	EffectiveDate    string // The date from which the IBAN format is in effect, as given by the SWIFT IBAN Registry
+	FixedChecksum    string // The IBAN check digits if they are a constant for this country, empty otherwise
+	HasNationalCheck bool   // Whether the national check digits of the BBAN are verified
+}
+
+// FieldSpec is a contiguous run of characters of one field in the IBAN.
+type FieldSpec struct {
+	Letter string // The letter of the field in the field layout, e.g. "b" for the bank code
+	Offset int    // The index (starting at 0) of the first character in the electronic IBAN
+	Length int    // The number of characters
+}
+
+// HasFixedChecksum reports whether the IBAN check digits are a constant for this country.
+func (c CountrySpec) HasFixedChecksum() bool {
+	return c.FixedChecksum != ""
+}
+
+// Fields returns the runs of the field layout in the order they appear in the IBAN.
+// The country code and the IBAN check digits are not included.
+func (c CountrySpec) Fields() []FieldSpec {
+	var fields []FieldSpec
+	position := 0
+	for i := 0; i < len(c.IBANFields); i++ {
+		letter := c.IBANFields[i]
+		if letter == ' ' {
+			continue
+		}
+		if letter >= 'a' && letter <= 'z' && letter != fieldChecksum {
+			last := len(fields) - 1
+			if last >= 0 && fields[last].Letter == string(letter) && fields[last].Offset+fields[last].Length == position {
+				fields[last].Length++
+			} else {
+				fields = append(fields, FieldSpec{Letter: string(letter), Offset: position, Length: 1})
+			}
+		}
+		position++
+	}
+	return fields
+}
+
+// LookupCountry returns the IBAN rules of the country with the given code, in upper or lower case.
+func LookupCountry(code string) (CountrySpec, bool) {
+	code = strings.ToUpper(code)
+	country, exists := countryList[code]
+	if !exists {
+		return CountrySpec{}, false
+	}
+	return newCountrySpec(code, country), true
+}
+
+// Countries returns the IBAN rules of all supported countries, sorted by country code.
+func Countries() []CountrySpec {
+	codes := SupportedCountryCodes()
+	specs := make([]CountrySpec, len(codes))
+	for i, code := range codes {
+		specs[i] = newCountrySpec(code, countryList[code])
+	}
+	return specs
+}
+
+// SupportedCountryCodes returns the codes of all supported countries in alphabetical order.
+func SupportedCountryCodes() []string {
+	codes := make([]string, 0, len(countryList))
+	for code := range countryList {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// newCountrySpec copies an entry of the country list into a CountrySpec.
+func newCountrySpec(code string, country ibanCountry) CountrySpec {
+	_, hasNationalCheck := lookupNationalCheck(code)
+	return CountrySpec{
+		Code:             code,
+		Name:             country.country,
+		Length:           country.chars,
+		BBANFormat:       country.bbanFormat,
+		IBANFields:       country.ibanFields,
+		Comment:          country.comment,
+		Example:          country.example,
+		BBANExample:      country.bbanExample,
+		BankPosition:     country.bankPosition,
+		BranchPosition:   country.branchPosition,
+		SEPA:             country.sepa,
+		EffectiveDate:    country.effectiveDate,
+		FixedChecksum:    country.fixedChecksum,
+		HasNationalCheck: hasNationalCheck,
+	}
+}
@@ -0,0 +1,65 @@
+package iban
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestCountryExamples(t *testing.T) {
+	for _, spec := range Countries() {
+		if spec.Example == "" {
+			continue
+		}
+		if _, err := NewIBAN(spec.Example); err != nil {
+			t.Errorf("%s: example %s is not valid: %v", spec.Code, spec.Example, err)
+		}
+	}
+}
+
+func TestLookupCountry(t *testing.T) {
+	spec, exists := LookupCountry("GB")
+	if !exists {
+		t.Fatal("GB not found")
+	}
+	if spec.Name != "United Kingdom" || spec.Length != 22 || spec.BBANFormat != "4a,14n" || spec.HasFixedChecksum() || spec.HasNationalCheck {
+		t.Errorf("got %+v", spec)
+	}
+
+	expected := []FieldSpec{{"b", 4, 4}, {"s", 8, 6}, {"c", 14, 8}}
+	fields := spec.Fields()
+	if len(fields) != len(expected) {
+		t.Fatalf("got %v, expected %v", fields, expected)
+	}
+	for i := range expected {
+		if fields[i] != expected[i] {
+			t.Errorf("got %v, expected %v", fields[i], expected[i])
+		}
+	}
+
+	if spec, _ := LookupCountry("PT"); spec.FixedChecksum != "50" || !spec.HasNationalCheck {
+		t.Errorf("got %+v", spec)
+	}
+	if spec, exists := LookupCountry("de"); !exists || spec.Code != "DE" {
+		t.Errorf("lower case de: got %+v", spec)
+	}
+	if _, exists := LookupCountry("XX"); exists {
+		t.Error("XX should not exist")
+	}
+}
+
+func TestCountriesAreCopies(t *testing.T) {
+	codes := SupportedCountryCodes()
+	if !sort.StringsAreSorted(codes) || len(codes) != len(countryList) {
+		t.Errorf("got %v", codes)
+	}
+
+	countries := Countries()
+	countries[0].Length = 0
+	codes[0] = "XX"
+	if spec, _ := LookupCountry(Countries()[0].Code); spec.Length == 0 {
+		t.Error("changing a returned spec changed the country list")
+	}
+	if SupportedCountryCodes()[0] == "XX" {
+		t.Error("changing the returned codes changed the country list")
+	}
+}
@@ -0,0 +1,116 @@
+package iban
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// errZeroIBAN is returned when the zero IBAN is stored in a column; NullIBAN is meant for optional columns.
+var errZeroIBAN = fmt.Errorf("%w: the zero IBAN cannot be stored, use NullIBAN for optional columns", ErrInvalidIBAN)
+
+// Value implements driver.Valuer. The IBAN is stored in its electronic format.
+func (i IBAN) Value() (driver.Value, error) {
+	if i == (IBAN{}) {
+		return nil, errZeroIBAN
+	}
+	return i.Electronic(), nil
+}
+
+// Scan implements sql.Scanner. The stored value is validated with NewIBAN and NULL is rejected.
+// Use CheckedIBAN for columns that may contain historical invalid values.
+func (i *IBAN) Scan(src any) error {
+	text, err := scanText(src, ErrInvalidIBAN, "an IBAN")
+	if err != nil {
+		return err
+	}
+	if text == nil {
+		return fmt.Errorf("%w: NULL cannot be scanned into an IBAN, use NullIBAN", ErrInvalidIBAN)
+	}
+	return i.UnmarshalText(text)
+}
+
+// NullIBAN represents an IBAN that may be NULL, like sql.NullString.
+type NullIBAN struct {
+	IBAN  IBAN
+	Valid bool // Valid is true if IBAN is not NULL
+}
+
+// Value implements driver.Valuer.
+func (n NullIBAN) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.IBAN.Value()
+}
+
+// Scan implements sql.Scanner. A stored value that is not NULL is validated with NewIBAN.
+func (n *NullIBAN) Scan(src any) error {
+	text, err := scanText(src, ErrInvalidIBAN, "an IBAN")
+	if err != nil {
+		return err
+	}
+	if text == nil {
+		*n = NullIBAN{}
+		return nil
+	}
+	if err := n.IBAN.UnmarshalText(text); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// CheckedIBAN scans an IBAN column that may contain historical invalid values without failing.
+// An invalid value is kept in Raw and flagged with the validation error in Err.
+// For NULL, IBAN is zero, Raw is empty and Err is nil.
+type CheckedIBAN struct {
+	IBAN IBAN   // The IBAN, zero if the stored value is NULL or invalid
+	Raw  string // The value as stored in the database
+	Err  error  // Why the stored value is invalid, nil if it is valid or NULL
+}
+
+// Null reports whether the stored value is NULL. A CheckedIBAN built with only the IBAN set is not NULL.
+func (c CheckedIBAN) Null() bool {
+	return c.IBAN == (IBAN{}) && c.Raw == "" && c.Err == nil
+}
+
+// Value implements driver.Valuer. A valid IBAN is stored in its electronic format,
+// an invalid value is stored back as it was read.
+func (c CheckedIBAN) Value() (driver.Value, error) {
+	switch {
+	case c.Err != nil:
+		return c.Raw, nil
+	case c.IBAN == (IBAN{}):
+		return nil, nil
+	default:
+		return c.IBAN.Value()
+	}
+}
+
+// Scan implements sql.Scanner. It only fails if the stored value is not text.
+func (c *CheckedIBAN) Scan(src any) error {
+	text, err := scanText(src, ErrInvalidIBAN, "an IBAN")
+	if err != nil {
+		return err
+	}
+	*c = CheckedIBAN{Raw: string(text)}
+	if text != nil {
+		c.IBAN, c.Err = NewIBAN(c.Raw)
+	}
+	return nil
+}
+
+// scanText returns the text of a database value, or nil for NULL. The error for other values
+// wraps invalid and names the target type.
+func scanText(src any, invalid error, target string) ([]byte, error) {
+	switch value := src.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []byte(value), nil
+	case []byte:
+		return value, nil
+	default:
+		return nil, fmt.Errorf("%w: cannot scan %T into %s", invalid, src, target)
+	}
+}
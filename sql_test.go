@@ -0,0 +1,96 @@
+package iban
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = (*IBAN)(nil)
+	_ driver.Valuer = IBAN{}
+	_ sql.Scanner   = (*NullIBAN)(nil)
+	_ driver.Valuer = NullIBAN{}
+	_ sql.Scanner   = (*CheckedIBAN)(nil)
+	_ driver.Valuer = CheckedIBAN{}
+)
+
+func TestIBANScanAndValue(t *testing.T) {
+	var value IBAN
+	for _, src := range []any{"GB82 WEST 1234 5698 7654 32", []byte("gb82west12345698765432")} {
+		if err := value.Scan(src); err != nil || value.BankCode != "WEST" {
+			t.Errorf("%v: got %+v (%v)", src, value, err)
+		}
+	}
+	if stored, err := value.Value(); err != nil || stored != "GB82WEST12345698765432" {
+		t.Errorf("got %v (%v)", stored, err)
+	}
+
+	for _, src := range []any{nil, "GB83 WEST 1234 5698 7654 32", 42} {
+		if err := value.Scan(src); !errors.Is(err, ErrInvalidIBAN) {
+			t.Errorf("%v: expected ErrInvalidIBAN, got %v", src, err)
+		}
+	}
+	if _, err := (IBAN{}).Value(); !errors.Is(err, ErrInvalidIBAN) {
+		t.Errorf("expected ErrInvalidIBAN for the zero IBAN, got %v", err)
+	}
+}
+
+func TestNullIBAN(t *testing.T) {
+	var value NullIBAN
+	if err := value.Scan(nil); err != nil || value.Valid {
+		t.Errorf("got %+v (%v)", value, err)
+	}
+	if stored, err := value.Value(); err != nil || stored != nil {
+		t.Errorf("got %v (%v)", stored, err)
+	}
+
+	if err := value.Scan("DE89370400440532013000"); err != nil || !value.Valid || value.IBAN.BankCode != "37040044" {
+		t.Errorf("got %+v (%v)", value, err)
+	}
+	if stored, err := value.Value(); err != nil || stored != "DE89370400440532013000" {
+		t.Errorf("got %v (%v)", stored, err)
+	}
+	if err := value.Scan("DE00370400440532013000"); !errors.Is(err, &ValidationError{Reason: BadChecksum}) {
+		t.Errorf("expected BadChecksum, got %v", err)
+	}
+}
+
+func TestCheckedIBAN(t *testing.T) {
+	var value CheckedIBAN
+	if err := value.Scan("DE00 3704 0044 0532 0130 00"); err != nil {
+		t.Fatal(err)
+	}
+	if value.Err == nil || value.Raw != "DE00 3704 0044 0532 0130 00" || value.IBAN != (IBAN{}) || value.Null() {
+		t.Errorf("got %+v", value)
+	}
+	if stored, err := value.Value(); err != nil || stored != value.Raw {
+		t.Errorf("the invalid value should be stored back unchanged, got %v (%v)", stored, err)
+	}
+
+	if err := value.Scan([]byte("DE89370400440532013000")); err != nil || value.Err != nil || value.IBAN.BankCode != "37040044" {
+		t.Errorf("got %+v (%v)", value, err)
+	}
+	if err := value.Scan(nil); err != nil || !value.Null() {
+		t.Errorf("got %+v (%v)", value, err)
+	}
+	if err := value.Scan(3.14); err == nil {
+		t.Error("expected an error for a number")
+	}
+
+	valid, err := NewIBAN("DE89370400440532013000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	literal := CheckedIBAN{IBAN: valid}
+	if literal.Null() {
+		t.Error("a CheckedIBAN with only the IBAN set should not be NULL")
+	}
+	if stored, err := literal.Value(); err != nil || stored != "DE89370400440532013000" {
+		t.Errorf("got %v (%v)", stored, err)
+	}
+	if !(CheckedIBAN{}).Null() {
+		t.Error("the zero CheckedIBAN should be NULL")
+	}
+}
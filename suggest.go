@@ -0,0 +1,158 @@
+package iban
+
+import (
+	"sort"
+	"strings"
+)
+
+// EditKind is the kind of typing mistake a Suggestion corrects.
+type EditKind int
+
+// The kinds of typing mistakes, from the most to the least plausible.
+const (
+	ConfusedGlyph         EditKind = iota + 1 // A character was confused with a similar looking one, e.g. 0 and O
+	AdjacentTransposition                     // Two neighbouring characters were swapped
+	Substitution                              // One character was mistyped
+)
+
+var editKindNames = map[EditKind]string{
+	ConfusedGlyph:         "ConfusedGlyph",
+	AdjacentTransposition: "AdjacentTransposition",
+	Substitution:          "Substitution",
+}
+
+// String returns the name of the edit kind, e.g. "ConfusedGlyph".
+func (k EditKind) String() string {
+	return editKindNames[k]
+}
+
+// Suggestion is a valid IBAN that differs from the input by one typing mistake.
+type Suggestion struct {
+	IBAN     IBAN     // The corrected IBAN
+	Kind     EditKind // The kind of mistake that was corrected
+	Position int      // The index (starting at 0) of the corrected character in the electronic IBAN
+}
+
+// confusedGlyphs lists for every character the characters it is commonly confused with.
+var confusedGlyphs = map[byte]string{
+	'0': "O", 'O': "0",
+	'1': "IL", 'I': "1L", 'L': "1I",
+	'5': "S", 'S': "5",
+	'8': "B", 'B': "8",
+	'2': "Z", 'Z': "2",
+}
+
+// suggestAlphabet are the characters tried for a substitution.
+const suggestAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// keyboardRows are the rows of a QWERTY keyboard; each row is shifted half a key to the right of the one above.
+var keyboardRows = []string{"1234567890", "QWERTYUIOP", "ASDFGHJKL", "ZXCVBNM"}
+
+// keyboardAdjacent reports whether the keys of a and b touch on a QWERTY keyboard.
+func keyboardAdjacent(a, b byte) bool {
+	row := func(c byte) (int, int) {
+		for r, keys := range keyboardRows {
+			if i := strings.IndexByte(keys, c); i >= 0 {
+				return r, i
+			}
+		}
+		return -1, -1
+	}
+	rowA, columnA := row(a)
+	rowB, columnB := row(b)
+	if rowA < 0 || rowB < 0 {
+		return false
+	}
+	switch rowB - rowA {
+	case 0:
+		return columnB == columnA-1 || columnB == columnA+1
+	case 1:
+		return columnB == columnA-1 || columnB == columnA
+	case -1:
+		return columnB == columnA || columnB == columnA+1
+	}
+	return false
+}
+
+// plausibility scores an edit, lower is more plausible. Confused glyphs come before transpositions,
+// which come before mistyped neighbouring keys and then other substitutions. Edits of the country
+// code or the check digits are less plausible than edits of the BBAN, as people read these back more carefully.
+func plausibility(kind EditKind, position int, from, to byte) int {
+	score := 0
+	switch kind {
+	case AdjacentTransposition:
+		score = 1
+	case Substitution:
+		score = 4
+		if keyboardAdjacent(from, to) {
+			score = 2
+		}
+	}
+	if position < 4 {
+		score += 3
+	}
+	return score
+}
+
+// Suggest returns the valid IBANs that differ from the input by one commonly confused glyph
+// (0/O, 1/I/L, 5/S, 8/B, 2/Z), one swap of neighbouring characters or one mistyped character.
+// Every suggestion passes all checks of NewIBAN. The suggestions are ranked by plausibility:
+// confused glyphs first, then transpositions, then mistyped neighbouring keys on a QWERTY keyboard,
+// then other substitutions, and edits of the BBAN before edits of the country code or check digits.
+// Equally plausible suggestions are ordered by position. A valid input has no suggestions.
+func Suggest(input string) []Suggestion {
+	iban, _ := Normalize(input)
+	if _, err := checkIban(iban); err == nil || len(iban) < 15 {
+		return nil
+	}
+
+	seen := map[string]bool{iban: true}
+	var suggestions []Suggestion
+	scores := map[string]int{}
+	try := func(candidate []byte, kind EditKind, position int) {
+		if seen[string(candidate)] {
+			return
+		}
+		seen[string(candidate)] = true
+		if formatted, err := checkIban(string(candidate)); err == nil {
+			suggestion := Suggestion{IBAN: newIBAN(formatted), Kind: kind, Position: position}
+			suggestions = append(suggestions, suggestion)
+			scores[suggestion.IBAN.Number] = plausibility(kind, position, iban[position], candidate[position])
+		}
+	}
+
+	candidate := []byte(iban)
+	for i := 0; i < len(iban); i++ {
+		for _, glyph := range []byte(confusedGlyphs[iban[i]]) {
+			candidate[i] = glyph
+			try(candidate, ConfusedGlyph, i)
+		}
+		candidate[i] = iban[i]
+	}
+	for i := 0; i+1 < len(iban); i++ {
+		if iban[i] == iban[i+1] {
+			continue
+		}
+		candidate[i], candidate[i+1] = iban[i+1], iban[i]
+		try(candidate, AdjacentTransposition, i)
+		candidate[i], candidate[i+1] = iban[i], iban[i+1]
+	}
+	for i := 0; i < len(iban); i++ {
+		for j := 0; j < len(suggestAlphabet); j++ {
+			candidate[i] = suggestAlphabet[j]
+			try(candidate, Substitution, i)
+		}
+		candidate[i] = iban[i]
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if scoreI, scoreJ := scores[suggestions[i].IBAN.Number], scores[suggestions[j].IBAN.Number]; scoreI != scoreJ {
+			return scoreI < scoreJ
+		}
+		if suggestions[i].Kind != suggestions[j].Kind {
+			return suggestions[i].Kind < suggestions[j].Kind
+		}
+		return suggestions[i].Position < suggestions[j].Position
+	})
+	return suggestions
+}
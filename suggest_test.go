@@ -0,0 +1,87 @@
+package iban
+
+import "testing"
+
+var suggestTests = []struct {
+	input    string
+	expected string
+	kind     EditKind
+	position int
+}{
+	{"GB82 WEST 1234 5698 7654 3Z", "GB82 WEST 1234 5698 7654 32", ConfusedGlyph, 21},
+	{"DE89 37O4 0044 0532 0130 00", "DE89 3704 0044 0532 0130 00", ConfusedGlyph, 6},
+	{"GB82 WEST 1234 5698 7645 32", "GB82 WEST 1234 5698 7654 32", AdjacentTransposition, 18},
+	{"DE89 3704 0044 0532 0131 00", "DE89 3704 0044 0532 0130 00", Substitution, 19},
+}
+
+func TestSuggest(t *testing.T) {
+	for _, test := range suggestTests {
+		suggestions := Suggest(test.input)
+		found := false
+		for i, suggestion := range suggestions {
+			if suggestion.IBAN.Number == test.expected {
+				found = true
+				if suggestion.Kind != test.kind || suggestion.Position != test.position {
+					t.Errorf("%s: got %s at %d, expected %s at %d", test.input, suggestion.Kind, suggestion.Position, test.kind, test.position)
+				}
+				if test.kind != Substitution && i != 0 {
+					t.Errorf("%s: expected %s to be ranked first, got rank %d", test.input, test.expected, i+1)
+				}
+			}
+			if _, err := NewIBAN(suggestion.IBAN.Number); err != nil {
+				t.Errorf("%s: suggestion %s is not valid: %v", test.input, suggestion.IBAN.Number, err)
+			}
+		}
+		if !found {
+			t.Errorf("%s: %s was not suggested, got %v", test.input, test.expected, suggestions)
+		}
+	}
+}
+
+func TestSuggestRanking(t *testing.T) {
+	for _, input := range []string{"DE89370400440532013100", "GB82WEST12345698765433", "DE89370400440532013009"} {
+		suggestions := Suggest(input)
+		score := func(s Suggestion) int {
+			return plausibility(s.Kind, s.Position, input[s.Position], s.IBAN.Electronic()[s.Position])
+		}
+		for i := 1; i < len(suggestions); i++ {
+			previous, current := suggestions[i-1], suggestions[i]
+			if score(previous) > score(current) || (score(previous) == score(current) && previous.Kind > current.Kind) {
+				t.Errorf("%s: suggestions %d and %d are not ranked: %+v, %+v", input, i, i+1, previous, current)
+			}
+		}
+	}
+
+	// A mistyped neighbouring key in the account number is more plausible than one in the check digits
+	if plausibility(Substitution, 21, '9', '0') >= plausibility(Substitution, 3, '9', '0') {
+		t.Error("an edit of the check digits should rank after the same edit of the BBAN")
+	}
+	if plausibility(Substitution, 21, '9', '0') >= plausibility(Substitution, 21, '9', '4') {
+		t.Error("a neighbouring key should rank before another substitution")
+	}
+	if plausibility(Substitution, 21, '9', '4') >= plausibility(Substitution, 2, '8', '0') {
+		t.Error("a substitution in the BBAN should rank before one in the check digits")
+	}
+}
+
+func TestKeyboardAdjacent(t *testing.T) {
+	for _, test := range []struct {
+		a, b     byte
+		adjacent bool
+	}{
+		{'1', '2', true}, {'9', '0', true}, {'W', 'S', true}, {'S', 'W', true}, {'E', 'S', true}, {'S', 'E', true},
+		{'M', 'K', true}, {'1', '0', false}, {'A', 'L', false}, {'Q', 'Z', false}, {'P', 'A', false}, {'1', '-', false},
+	} {
+		if keyboardAdjacent(test.a, test.b) != test.adjacent {
+			t.Errorf("%c %c: expected %v", test.a, test.b, test.adjacent)
+		}
+	}
+}
+
+func TestSuggestValidOrShort(t *testing.T) {
+	for _, input := range []string{"GB82 WEST 1234 5698 7654 32", "GB82", ""} {
+		if suggestions := Suggest(input); suggestions != nil {
+			t.Errorf("%q: expected no suggestions, got %v", input, suggestions)
+		}
+	}
+}
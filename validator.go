@@ -0,0 +1,191 @@
+package iban
+
+import (
+	"log/slog"
+	"strings"
+)
+
+// Rule is a custom acceptance rule of a Validator. It is called with IBANs that passed
+// all other checks and returns an error to reject them.
+type Rule func(IBAN) error
+
+// Validator validates IBAN numbers with configurable acceptance policies on top of the IBAN rules.
+// A Validator is safe for concurrent use once created.
+type Validator struct {
+	allowed              map[string]bool
+	blocked              map[string]bool
+	sepaOnly             bool
+	requireNationalCheck bool
+	strict               bool
+	rules                []Rule
+	germanBanks          BankDirectory
+	logger               *slog.Logger
+	debug                bool
+}
+
+// Option configures a Validator.
+type Option func(*Validator)
+
+// defaultValidator is used by NewIBAN and IsCorrectIban.
+var defaultValidator = NewValidator()
+
+// NewValidator creates a Validator with the given options.
+// Without options it accepts every IBAN that NewIBAN accepts.
+func NewValidator(options ...Option) *Validator {
+	v := &Validator{}
+	for _, option := range options {
+		option(v)
+	}
+	return v
+}
+
+// WithAllowedCountries only accepts IBANs of the given countries.
+func WithAllowedCountries(countryCodes ...string) Option {
+	return func(v *Validator) {
+		if v.allowed == nil {
+			v.allowed = map[string]bool{}
+		}
+		for _, code := range countryCodes {
+			v.allowed[strings.ToUpper(code)] = true
+		}
+	}
+}
+
+// WithBlockedCountries rejects IBANs of the given countries.
+func WithBlockedCountries(countryCodes ...string) Option {
+	return func(v *Validator) {
+		if v.blocked == nil {
+			v.blocked = map[string]bool{}
+		}
+		for _, code := range countryCodes {
+			v.blocked[strings.ToUpper(code)] = true
+		}
+	}
+}
+
+// WithSEPAOnly only accepts IBANs of countries that are part of the Single Euro Payments Area.
+func WithSEPAOnly() Option {
+	return func(v *Validator) {
+		v.sepaOnly = true
+	}
+}
+
+// WithRequiredNationalCheck only accepts IBANs of countries whose national check digits are verified.
+func WithRequiredNationalCheck() Option {
+	return func(v *Validator) {
+		v.requireNationalCheck = true
+	}
+}
+
+// WithStrictFormat only accepts IBANs in the electronic format: IBANs that Normalize does not change.
+func WithStrictFormat() Option {
+	return func(v *Validator) {
+		v.strict = true
+	}
+}
+
+// WithGermanAccountCheck also checks the account numbers of German IBANs with the check method of
+// their bank (see CheckGermanAccount). The banks are looked up in the directory, usually filled with
+// ReadBundesbankFile; IBANs of banks that are not in it or have no known check method are accepted.
+func WithGermanAccountCheck(directory BankDirectory) Option {
+	return func(v *Validator) {
+		v.germanBanks = directory
+	}
+}
+
+// WithRule adds a custom acceptance rule. Rules run in the order they were added.
+func WithRule(rule Rule) Option {
+	return func(v *Validator) {
+		v.rules = append(v.rules, rule)
+	}
+}
+
+// WithLogger sets the logger of the Validator instead of the logger set with SetLogger.
+func WithLogger(l *slog.Logger) Option {
+	return func(v *Validator) {
+		v.logger = l
+	}
+}
+
+// WithDebug logs rejected IBANs at info level and accepted IBANs as well.
+func WithDebug() Option {
+	return func(v *Validator) {
+		v.debug = true
+	}
+}
+
+// Validate checks the IBAN number against the IBAN rules and the policies of the Validator.
+// If the IBAN is accepted, it returns the IBAN struct with its different parts filled in.
+// Otherwise it returns a *ValidationError, which wraps ErrInvalidIBAN.
+func (v *Validator) Validate(ibanNumber string) (IBAN, error) {
+	return v.validate(ibanNumber, v.debug)
+}
+
+// IsValid reports whether the IBAN number is accepted by the Validator.
+func (v *Validator) IsValid(ibanNumber string) bool {
+	_, err := v.validate(ibanNumber, v.debug)
+	return err == nil
+}
+
+// validate checks the IBAN number and logs the result.
+func (v *Validator) validate(ibanNumber string, debug bool) (IBAN, error) {
+	result, err := v.check(ibanNumber)
+	l := v.logger
+	if l == nil {
+		l = logger.Load()
+	}
+	logEvent(l, debug, ibanNumber, err)
+	if err != nil {
+		return IBAN{}, err
+	}
+	return result, nil
+}
+
+// check applies the IBAN rules and then the policies of the Validator.
+func (v *Validator) check(ibanNumber string) (IBAN, error) {
+	if v.strict {
+		if _, changes := Normalize(ibanNumber); changes.Changed() {
+			return IBAN{}, notElectronicFormat(ibanNumber)
+		}
+	}
+
+	formatted, err := checkIban(ibanNumber)
+	if err != nil {
+		return IBAN{}, err
+	}
+	result := newIBAN(formatted)
+	code := result.CountryCode
+
+	if (v.allowed != nil && !v.allowed[code]) || v.blocked[code] {
+		return IBAN{}, &ValidationError{Reason: CountryNotAllowed, Country: code, Actual: code, Position: 0}
+	}
+	if v.sepaOnly && !countryList[code].sepa {
+		return IBAN{}, &ValidationError{Reason: NotSEPA, Country: code, Actual: code, Position: 0}
+	}
+	if _, hasCheck := lookupNationalCheck(code); v.requireNationalCheck && !hasCheck {
+		return IBAN{}, &ValidationError{Reason: NationalCheckRequired, Country: code, Position: -1}
+	}
+	if v.germanBanks != nil && code == "DE" {
+		if err := checkGermanAccountDigit(v.germanBanks, result); err != nil {
+			return IBAN{}, err
+		}
+	}
+
+	for _, rule := range v.rules {
+		if err := rule(result); err != nil {
+			return IBAN{}, &ValidationError{Reason: RuleViolation, Country: code, Position: -1, Err: err}
+		}
+	}
+	return result, nil
+}
+
+// notElectronicFormat reports the first character of the IBAN number that Normalize removes or transforms.
+func notElectronicFormat(ibanNumber string) *ValidationError {
+	for i, r := range ibanNumber {
+		if mapped, keep := normalizeRune(r); !keep || mapped != r {
+			return &ValidationError{Reason: NotElectronicFormat, Actual: string(r), Position: i}
+		}
+	}
+	// Only the "IBAN" prefix was removed
+	return &ValidationError{Reason: NotElectronicFormat, Actual: "IBAN", Position: 0}
+}
@@ -0,0 +1,61 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+var validatorTests = []struct {
+	name    string
+	options []Option
+	number  string
+	reason  Reason // zero if the IBAN is accepted
+}{
+	{"default", nil, "gb82 west 1234 5698 7654 32", 0},
+	{"allowed", []Option{WithAllowedCountries("de", "GB")}, "GB82WEST12345698765432", 0},
+	{"not allowed", []Option{WithAllowedCountries("DE")}, "GB82WEST12345698765432", CountryNotAllowed},
+	{"blocked", []Option{WithBlockedCountries("GB")}, "GB82WEST12345698765432", CountryNotAllowed},
+	{"not blocked", []Option{WithBlockedCountries("GB")}, "DE89370400440532013000", 0},
+	{"SEPA", []Option{WithSEPAOnly()}, "DE89370400440532013000", 0},
+	{"not SEPA", []Option{WithSEPAOnly()}, "EG210003700067100239218937900", NotSEPA},
+	{"national check", []Option{WithRequiredNationalCheck()}, "BE68539007547034", 0},
+	{"no national check", []Option{WithRequiredNationalCheck()}, "DE89370400440532013000", NationalCheckRequired},
+	{"strict", []Option{WithStrictFormat()}, "GB82WEST12345698765432", 0},
+	{"strict with spaces", []Option{WithStrictFormat()}, "GB82 WEST 1234 5698 7654 32", NotElectronicFormat},
+	{"strict lower case", []Option{WithStrictFormat()}, "gb82WEST12345698765432", NotElectronicFormat},
+	{"invalid", []Option{WithAllowedCountries("GB")}, "GB83WEST12345698765432", BadChecksum},
+}
+
+func TestValidator(t *testing.T) {
+	for _, test := range validatorTests {
+		validator := NewValidator(test.options...)
+		result, err := validator.Validate(test.number)
+		if test.reason == 0 {
+			if err != nil || result == (IBAN{}) || !validator.IsValid(test.number) {
+				t.Errorf("%s: expected %s to be accepted, got %v", test.name, test.number, err)
+			}
+			continue
+		}
+		if !errors.Is(err, &ValidationError{Reason: test.reason}) || validator.IsValid(test.number) {
+			t.Errorf("%s: expected %s, got %v", test.name, test.reason, err)
+		}
+	}
+}
+
+func TestValidatorRule(t *testing.T) {
+	errNoWest := errors.New("WEST accounts are not accepted")
+	validator := NewValidator(WithRule(func(i IBAN) error {
+		if i.BankCode == "WEST" {
+			return errNoWest
+		}
+		return nil
+	}))
+
+	_, err := validator.Validate("GB82 WEST 1234 5698 7654 32")
+	if !errors.Is(err, errNoWest) || !errors.Is(err, &ValidationError{Reason: RuleViolation}) || !errors.Is(err, ErrInvalidIBAN) {
+		t.Errorf("got %v", err)
+	}
+	if !validator.IsValid("DE89 3704 0044 0532 0130 00") {
+		t.Error("expected the rule to accept other banks")
+	}
+}